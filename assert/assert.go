@@ -1,5 +1,7 @@
 package assert
 
+import "github.com/danyloB/Testing/should"
+
 type Assertion func(actual interface{}, expected ...interface{}) error
 
 // So runs the provided Assertion and returns the error, as in:
@@ -44,6 +46,6 @@ func (this TestingT) So(actual interface{}, assertion Assertion, expected ...int
 	err := assertion(actual, expected...)
 	if err != nil {
 		this.helper()
-		this.report(err)
+		this.report(should.Describe(err))
 	}
 }
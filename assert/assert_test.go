@@ -86,6 +86,17 @@ func TestFatal_Fail_Fatals(t *testing.T) {
 	})
 }
 
+func TestError_Fail_ReportsDetailedErrors(t *testing.T) {
+	fakeT := new(FakeT)
+
+	assert.Error(fakeT).So(1, shouldFailWithReport)
+
+	assertEqual(t, fakeT, &FakeT{
+		helps:  1,
+		errors: []string{"detailed failure"},
+	})
+}
+
 func assertEqual(t *testing.T, actual, expected interface{}) {
 	if reflect.DeepEqual(actual, expected) {
 		return
@@ -123,6 +134,17 @@ func shouldFail(actual interface{}, expected ...interface{}) error {
 	return errors.New("failure")
 }
 
+func shouldFailWithReport(actual interface{}, expected ...interface{}) error {
+	_ = actual
+	_ = expected
+	return fmt.Errorf("wrapped: %w", reportingError{})
+}
+
+type reportingError struct{}
+
+func (reportingError) Error() string  { return "failure" }
+func (reportingError) Report() string { return "detailed failure" }
+
 type FakeT struct {
 	helps  int
 	logs   []string
@@ -0,0 +1,275 @@
+package should
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+)
+
+// DefaultDelta is the absolute tolerance used by
+// AlmostEqual when no Tolerance is provided.
+const DefaultDelta = 0.0000000001
+
+// AlmostEqual verifies that the numeric actual value is within a tolerance
+// of the numeric expected[0] value. The tolerance may be provided as
+// expected[1], either as a Tolerance (see WithinDelta, WithinEpsilon and
+// WithinULPs) or as a plain number (treated as an absolute delta). When
+// omitted, DefaultDelta is used. Operands may be of any numeric kind.
+//
+// NaN is never almost equal to anything (including NaN). An infinity is
+// almost equal only to an infinity of the same sign, whatever the tolerance.
+// When both operands are integers they are compared exactly (rather than
+// as float64 values), in which case their ULP distance is their difference.
+func AlmostEqual(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpectedRange(1, 2, expected)
+	if err != nil {
+		return err
+	}
+
+	tolerance := WithinDelta(DefaultDelta)
+	if len(expected) == 2 {
+		tolerance, err = toleranceFrom(expected[1])
+		if err != nil {
+			return err
+		}
+	}
+
+	err = validateKind(actual, numericKindList...)
+	if err != nil {
+		return err
+	}
+
+	err = validateKind(expected[0], numericKindList...)
+	if err != nil {
+		return err
+	}
+
+	a := reflect.ValueOf(actual)
+	b := reflect.ValueOf(expected[0])
+	distance, within := tolerance.compare(a, b)
+	if within {
+		return nil
+	}
+
+	return failure("\n"+
+		"  expected: %v\n"+
+		"  actual:   %v\n"+
+		"  distance: %s, want <= %s",
+		expected[0],
+		actual,
+		distance,
+		tolerance,
+	)
+}
+
+// AlmostEqual (negated!)
+func (negated) AlmostEqual(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = AlmostEqual(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("\n"+
+		"  expected:            %v\n"+
+		"  to not almost equal: %v\n"+
+		"  (but it did)",
+		expected[0],
+		actual,
+	)
+}
+
+// Tolerance describes how far apart two numbers may be and still be
+// considered almost equal by AlmostEqual. Use WithinDelta, WithinEpsilon
+// or WithinULPs to create one.
+type Tolerance struct {
+	mode   toleranceMode
+	amount float64
+	ulps   uint64
+}
+
+type toleranceMode int
+
+const (
+	absoluteTolerance toleranceMode = iota
+	relativeTolerance
+	ulpTolerance
+)
+
+// WithinDelta creates a Tolerance which allows an
+// absolute difference of at most delta.
+func WithinDelta(delta float64) Tolerance {
+	return Tolerance{mode: absoluteTolerance, amount: math.Abs(delta)}
+}
+
+// WithinEpsilon creates a Tolerance which allows a difference of at most
+// epsilon relative to the larger magnitude of the two values being compared.
+func WithinEpsilon(epsilon float64) Tolerance {
+	return Tolerance{mode: relativeTolerance, amount: math.Abs(epsilon)}
+}
+
+// WithinULPs creates a Tolerance which allows the values to be at most
+// n representable floating point values (units in the last place) apart.
+// Float32 operands are compared at float32 precision, all others at
+// float64 precision.
+func WithinULPs(n uint64) Tolerance {
+	return Tolerance{mode: ulpTolerance, ulps: n}
+}
+
+func (this Tolerance) String() string {
+	switch this.mode {
+	case relativeTolerance:
+		return fmt.Sprintf("%g (relative epsilon)", this.amount)
+	case ulpTolerance:
+		return fmt.Sprintf("%d ULPs", this.ulps)
+	default:
+		return fmt.Sprintf("%g (absolute delta)", this.amount)
+	}
+}
+
+// compare returns a description of the distance between a and b
+// (in the units of the tolerance) and whether it was within tolerance.
+func (this Tolerance) compare(a, b reflect.Value) (distance string, within bool) {
+	if !isFloatKind(a.Kind()) && !isFloatKind(b.Kind()) {
+		return this.compareIntegers(asBigInt(a), asBigInt(b))
+	}
+
+	x, y := asFloat64(a), asFloat64(b)
+	switch {
+	case math.IsNaN(x) || math.IsNaN(y):
+		return "NaN", false
+	case math.IsInf(x, 0) || math.IsInf(y, 0):
+		if x == y {
+			return "0", true
+		}
+		return "+Inf", false
+	}
+
+	switch this.mode {
+	case relativeTolerance:
+		largest := math.Max(math.Abs(x), math.Abs(y))
+		relative := 0.0
+		if largest > 0 {
+			relative = math.Abs(x-y) / largest
+		}
+		return fmt.Sprintf("%g (relative)", relative), relative <= this.amount
+	case ulpTolerance:
+		var ulps uint64
+		if a.Kind() == reflect.Float32 && b.Kind() == reflect.Float32 {
+			ulps = ulpDistance32(float32(x), float32(y))
+		} else {
+			ulps = ulpDistance64(x, y)
+		}
+		return fmt.Sprintf("%d ULPs", ulps), ulps <= this.ulps
+	default:
+		delta := math.Abs(x - y)
+		return fmt.Sprintf("%g (absolute)", delta), delta <= this.amount
+	}
+}
+
+// compareIntegers is like compare, but avoids the loss of precision
+// incurred by converting integers beyond 2^53 to float64.
+func (this Tolerance) compareIntegers(x, y *big.Int) (distance string, within bool) {
+	difference := new(big.Int).Abs(new(big.Int).Sub(x, y))
+	switch this.mode {
+	case relativeTolerance:
+		largest := new(big.Int).Abs(x)
+		if abs := new(big.Int).Abs(y); abs.Cmp(largest) > 0 {
+			largest = abs
+		}
+		relative := 0.0
+		if largest.Sign() > 0 {
+			relative, _ = new(big.Rat).SetFrac(difference, largest).Float64()
+		}
+		return fmt.Sprintf("%g (relative)", relative), atMost(difference, this.amount, largest)
+	case ulpTolerance:
+		return difference.String() + " ULPs", difference.Cmp(new(big.Int).SetUint64(this.ulps)) <= 0
+	default:
+		return difference.String() + " (absolute)", atMost(difference, this.amount, big.NewInt(1))
+	}
+}
+
+// atMost reports whether difference <= limit * scale, exactly.
+func atMost(difference *big.Int, limit float64, scale *big.Int) bool {
+	if math.IsNaN(limit) {
+		return false
+	}
+	if math.IsInf(limit, 1) {
+		return true
+	}
+	bound := new(big.Rat).Mul(new(big.Rat).SetFloat64(limit), new(big.Rat).SetInt(scale))
+	return new(big.Rat).SetInt(difference).Cmp(bound) <= 0
+}
+
+func toleranceFrom(v interface{}) (Tolerance, error) {
+	if tolerance, ok := v.(Tolerance); ok {
+		return tolerance, nil
+	}
+	err := validateKind(v, numericKindList...)
+	if err != nil {
+		return Tolerance{}, err
+	}
+	return WithinDelta(asFloat64(reflect.ValueOf(v))), nil
+}
+
+func asBigInt(v reflect.Value) *big.Int {
+	if isSignedKind(v.Kind()) {
+		return big.NewInt(v.Int())
+	}
+	return new(big.Int).SetUint64(v.Uint())
+}
+
+func asFloat64(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	default:
+		return v.Float()
+	}
+}
+
+// ulpDistance64 counts the representable float64 values between a and b.
+func ulpDistance64(a, b float64) uint64 {
+	x, y := orderedBits64(a), orderedBits64(b)
+	if x > y {
+		return x - y
+	}
+	return y - x
+}
+
+// ulpDistance32 counts the representable float32 values between a and b.
+func ulpDistance32(a, b float32) uint64 {
+	x, y := orderedBits32(a), orderedBits32(b)
+	if x > y {
+		return uint64(x - y)
+	}
+	return uint64(y - x)
+}
+
+// orderedBits64 maps the bits of f onto an unsigned integer such that
+// the ordering of floats is preserved (and -0 and +0 coincide).
+func orderedBits64(f float64) uint64 {
+	const sign = 1 << 63
+	bits := math.Float64bits(f)
+	if bits&sign != 0 {
+		return sign - bits&^sign
+	}
+	return sign + bits
+}
+func orderedBits32(f float32) uint32 {
+	const sign = 1 << 31
+	bits := math.Float32bits(f)
+	if bits&sign != 0 {
+		return sign - bits&^sign
+	}
+	return sign + bits
+}
@@ -0,0 +1,78 @@
+package should_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestShouldAlmostEqual(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1.0, should.AlmostEqual)
+	assert.ExpectedCountInvalid(1.0, should.AlmostEqual, 1.0, 0.1, "EXTRA")
+
+	assert.KindMismatch("1.0", should.AlmostEqual, 1.0)
+	assert.KindMismatch(1.0, should.AlmostEqual, "1.0")
+	assert.KindMismatch(1.0, should.AlmostEqual, 1.0, "0.1")
+
+	// default delta:
+	assert.Pass(0.1+0.2, should.AlmostEqual, 0.3)
+	assert.Fail(1.0, should.AlmostEqual, 1.001)
+
+	// absolute delta (across numeric kinds):
+	assert.Pass(1.0, should.AlmostEqual, 1.05, 0.1)
+	assert.Pass(1, should.AlmostEqual, float32(1.05), should.WithinDelta(0.1))
+	assert.Pass(uint8(10), should.AlmostEqual, int64(11), 1)
+	assert.Fail(1.0, should.AlmostEqual, 1.2, should.WithinDelta(0.1))
+
+	// relative epsilon:
+	assert.Pass(1000000.0, should.AlmostEqual, 1000001.0, should.WithinEpsilon(0.000001))
+	assert.Fail(1.0, should.AlmostEqual, 1.1, should.WithinEpsilon(0.000001))
+	assert.Pass(0.0, should.AlmostEqual, 0.0, should.WithinEpsilon(0))
+
+	// units in the last place:
+	assert.Pass(1.0, should.AlmostEqual, math.Nextafter(1.0, 2), should.WithinULPs(1))
+	assert.Fail(1.0, should.AlmostEqual, math.Nextafter(math.Nextafter(1.0, 2), 2), should.WithinULPs(1))
+	assert.Pass(float32(1.0), should.AlmostEqual, math.Nextafter32(1.0, 2), should.WithinULPs(1))
+	assert.Pass(math.Copysign(0, -1), should.AlmostEqual, 0.0, should.WithinULPs(0))
+	assert.Pass(-math.SmallestNonzeroFloat64, should.AlmostEqual, math.SmallestNonzeroFloat64, should.WithinULPs(2))
+
+	// integers (compared exactly, even beyond 2^53):
+	assert.Fail(int64(1<<53+1), should.AlmostEqual, int64(1<<53), should.WithinDelta(0))
+	assert.Pass(int64(1<<53+1), should.AlmostEqual, int64(1<<53), should.WithinDelta(1))
+	assert.Fail(uint64(math.MaxUint64), should.AlmostEqual, uint64(math.MaxUint64-1), should.WithinULPs(0))
+	assert.Pass(uint64(math.MaxUint64), should.AlmostEqual, int64(math.MinInt64), should.WithinDelta(math.Inf(1)))
+	assert.Pass(int64(1000000), should.AlmostEqual, int64(1000001), should.WithinEpsilon(0.000001))
+	assert.Fail(int64(1<<60), should.AlmostEqual, int64(1<<60+2), should.WithinEpsilon(0))
+	assert.Fail(1, should.AlmostEqual, 1, should.WithinDelta(math.NaN()))
+
+	// NaN and infinities:
+	assert.Fail(math.NaN(), should.AlmostEqual, math.NaN(), math.Inf(1))
+	assert.Fail(math.NaN(), should.AlmostEqual, 1.0, math.Inf(1))
+	assert.Pass(math.Inf(1), should.AlmostEqual, math.Inf(1))
+	assert.Fail(math.Inf(1), should.AlmostEqual, math.Inf(-1), math.Inf(1))
+	assert.Fail(math.Inf(1), should.AlmostEqual, math.MaxFloat64, should.WithinULPs(math.MaxUint64))
+}
+
+func TestShouldNotAlmostEqual(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1.0, should.NOT.AlmostEqual)
+	assert.KindMismatch("1.0", should.NOT.AlmostEqual, 1.0)
+
+	assert.Fail(1.0, should.NOT.AlmostEqual, 1.05, 0.1)
+	assert.Pass(1.0, should.NOT.AlmostEqual, 1.2, 0.1)
+	assert.Pass(math.NaN(), should.NOT.AlmostEqual, math.NaN())
+}
+
+func TestShouldNotAlmostEqualAlignsReport(t *testing.T) {
+	err := should.NOT.AlmostEqual(1.0, 1.05, 0.1)
+
+	assertReportContains(t, err, "\n"+
+		"  expected:            1.05\n"+
+		"  to not almost equal: 1\n"+
+		"  (but it did)",
+	)
+}
@@ -27,6 +27,22 @@ func (this *Assertion) KindMismatch(actual interface{}, assertion assertion, exp
 	this.Helper()
 	this.err(actual, assertion, expected, should.ErrKindMismatch)
 }
+func (this *Assertion) InvalidPattern(actual interface{}, assertion assertion, expected ...interface{}) {
+	this.Helper()
+	this.err(actual, assertion, expected, should.ErrInvalidPattern)
+}
+func (this *Assertion) InvalidJSON(actual interface{}, assertion assertion, expected ...interface{}) {
+	this.Helper()
+	this.err(actual, assertion, expected, should.ErrInvalidJSON)
+}
+func (this *Assertion) InvalidArgument(actual interface{}, assertion assertion, expected ...interface{}) {
+	this.Helper()
+	this.err(actual, assertion, expected, should.ErrInvalidArgument)
+}
+func (this *Assertion) InvalidFieldPath(actual interface{}, assertion assertion, expected ...interface{}) {
+	this.Helper()
+	this.err(actual, assertion, expected, should.ErrInvalidFieldPath)
+}
 func (this *Assertion) Fail(actual interface{}, assertion assertion, expected ...interface{}) {
 	this.Helper()
 	this.err(actual, assertion, expected, should.ErrAssertionFailure)
@@ -0,0 +1,115 @@
+package should
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// AssertionError describes the failure of an assertion. It satisfies
+// errors.Is(err, ErrAssertionFailure) and may be retrieved from the
+// error returned by any assertion in this package via errors.As.
+type AssertionError struct {
+	Assertion string          // the name of the assertion, as in "should.Equal" or "should.NOT.Equal"
+	Actual    interface{}     // the actual value provided to the assertion
+	Expected  []interface{}   // the expected values provided to the assertion
+	Diff      string          // a diff of the actual and expected values (if available)
+	Message   string          // a description of the failure
+	File      string          // the file from which the assertion was invoked
+	Line      int             // the line (of the file) from which the assertion was invoked
+	Stack     []runtime.Frame // the frames (see DefaultStackFilter) from which the assertion was invoked
+}
+
+func (this *AssertionError) Error() string {
+	return ErrAssertionFailure.Error() + ": " + this.Message
+}
+
+func (this *AssertionError) Unwrap() error {
+	return ErrAssertionFailure
+}
+
+// Location renders the file (base name only) and line from which
+// the assertion was invoked, as in "service_test.go:42".
+func (this *AssertionError) Location() string {
+	if this.File == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", this.File[strings.LastIndex(this.File, "/")+1:], this.Line)
+}
+
+// Report renders the failure under a heading naming the assertion
+// and the location from which it was invoked, followed by the stack.
+func (this *AssertionError) Report() string {
+	heading := this.Assertion + " failed"
+	if location := this.Location(); location != "" {
+		heading += " at " + location
+	}
+	return heading + ": " + strings.TrimPrefix(this.Message, " ") + this.stack()
+}
+
+func (this *AssertionError) stack() string {
+	if len(this.Stack) == 0 {
+		return ""
+	}
+	return "\nStack (filtered):\n" + formatStack(this.Stack)
+}
+
+// Describe renders the error via its Report method (as implemented by
+// *AssertionError), if it has one, or via its Error method otherwise.
+func Describe(err error) string {
+	var detailed interface{ Report() string }
+	if errors.As(err, &detailed) {
+		return detailed.Report()
+	}
+	return err.Error()
+}
+
+func failure(format string, args ...interface{}) error {
+	return &AssertionError{Message: fmt.Sprintf(format, args...)}
+}
+
+// diffFailure is like failure, but also records the diff
+// (already included in the message) of actual and expected.
+func diffFailure(diff string, format string, args ...interface{}) error {
+	return &AssertionError{Message: fmt.Sprintf(format, args...), Diff: diff}
+}
+
+// annotate records (on any *AssertionError) the name of the assertion
+// from which it is deferred, the actual and expected values provided to
+// that assertion, and the (filtered) stack and location from which that
+// assertion was invoked. As assertions may invoke other assertions, the
+// outermost one prevails.
+func annotate(err *error, actual interface{}, expected []interface{}) {
+	assertionErr, ok := (*err).(*AssertionError)
+	if !ok {
+		return
+	}
+
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	frame, _ := frames.Next()
+	assertionErr.Assertion = assertionName(frame.Function)
+	assertionErr.Actual = actual
+	assertionErr.Expected = expected
+	assertionErr.Stack = DefaultStackFilter.filter(frames)
+	assertionErr.File, assertionErr.Line = "", 0
+	if len(assertionErr.Stack) > 0 {
+		assertionErr.File, assertionErr.Line = assertionErr.Stack[0].File, assertionErr.Stack[0].Line
+	}
+}
+
+// assertionName shortens the name of an assertion function, as in:
+// "github.com/.../should.negated.Equal" -> "should.NOT.Equal".
+func assertionName(function string) string {
+	name := strings.TrimPrefix(function, packagePath)
+	name = strings.TrimSuffix(name, "-fm")
+	if closure := strings.Index(name, ".func"); closure >= 0 {
+		name = name[:closure]
+	}
+	return "should" + strings.Replace(name, ".negated.", ".NOT.", 1)
+}
+
+// packagePath is the import path of this package (as in "github.com/.../should").
+var packagePath = reflect.TypeOf(AssertionError{}).PkgPath()
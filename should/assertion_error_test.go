@@ -0,0 +1,114 @@
+package should_test
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mdwhatcott/testing/assert"
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestAssertionErrorDescribesFailure(t *testing.T) {
+	err, line := should.Equal(1, 2), currentLine()
+
+	failure := asAssertionError(t, err)
+	if !errors.Is(err, should.ErrAssertionFailure) {
+		t.Error("should satisfy errors.Is(err, ErrAssertionFailure)")
+	}
+	if failure.Assertion != "should.Equal" {
+		t.Errorf("assertion: got %q", failure.Assertion)
+	}
+	if failure.Actual != 1 || !reflect.DeepEqual(failure.Expected, []interface{}{2}) {
+		t.Errorf("actual/expected: got %#v, %#v", failure.Actual, failure.Expected)
+	}
+	if filepath.Base(failure.File) != "assertion_error_test.go" || failure.Line != line {
+		t.Errorf("location: got %s:%d, want line %d", failure.File, failure.Line, line)
+	}
+	if !strings.HasPrefix(err.Error(), "assertion failure: ") || !strings.Contains(err.Error(), failure.Message) {
+		t.Errorf("unexpected error message: %s", err)
+	}
+}
+
+func TestAssertionErrorNamesOutermostAssertion(t *testing.T) {
+	for _, test := range []struct {
+		err       error
+		assertion string
+		actual    interface{}
+	}{
+		{should.NOT.Equal(1, 1), "should.NOT.Equal", 1},
+		{should.BeIn(4, []int{1, 2}), "should.BeIn", 4},
+		{should.NOT.BeIn(1, []int{1, 2}), "should.NOT.BeIn", 1},
+		{should.All(should.Bind(should.Equal, 2))(1), "should.All", 1},
+		{should.Bind(should.Equal, 2)(1), "should.Equal", 1},
+		{should.Each(should.Equal, 2)([]int{1}), "should.Each", []int{1}},
+	} {
+		failure := asAssertionError(t, test.err)
+		if failure.Assertion != test.assertion || !reflect.DeepEqual(failure.Actual, test.actual) {
+			t.Errorf("got %s(%#v), want %s(%#v)", failure.Assertion, failure.Actual, test.assertion, test.actual)
+		}
+	}
+}
+
+func TestAssertionErrorLocatesCallerOfSo(t *testing.T) {
+	err, line := assert.So(1, should.Equal, 2), currentLine()
+
+	failure := asAssertionError(t, err)
+	if filepath.Base(failure.File) != "assertion_error_test.go" || failure.Line != line {
+		t.Errorf("location: got %s:%d, want line %d", failure.File, failure.Line, line)
+	}
+}
+
+func TestAssertionErrorRecordsDiff(t *testing.T) {
+	failure := asAssertionError(t, should.Equal(Owner{Name: "Alice"}, Owner{Name: "Bob"}))
+
+	if failure.Diff != `  .Name: "Alice" != "Bob"` {
+		t.Errorf("diff: got %q", failure.Diff)
+	}
+	if !strings.Contains(failure.Message, failure.Diff) {
+		t.Error("the diff should also be included in the message")
+	}
+}
+
+func TestAssertionErrorReport(t *testing.T) {
+	err, line := should.BeTrue(false), currentLine()
+
+	report := asAssertionError(t, err).Report()
+
+	expected := "should.BeTrue failed at assertion_error_test.go:" + strconv.Itoa(line) + ": "
+	if !strings.HasPrefix(report, expected) {
+		t.Errorf("got %q, want prefix %q", report, expected)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	failure := should.BeTrue(false)
+	wrapped := fmt.Errorf("wrapped: %w", failure)
+	plain := errors.New("plain")
+
+	if described := should.Describe(wrapped); described != asAssertionError(t, failure).Report() {
+		t.Errorf("failures should be described by their report, got: %s", described)
+	}
+	if described := should.Describe(plain); described != "plain" {
+		t.Errorf("other errors should be described by their message, got: %s", described)
+	}
+}
+
+func asAssertionError(t *testing.T, err error) *should.AssertionError {
+	t.Helper()
+	var failure *should.AssertionError
+	if !errors.As(err, &failure) {
+		t.Fatalf("expected an *AssertionError, got: %#v", err)
+	}
+	return failure
+}
+
+func currentLine() int {
+	_, _, line, _ := runtime.Caller(1)
+	return line
+}
@@ -6,8 +6,9 @@ import (
 )
 
 // BeEmpty uses reflection to verify that len(actual) == 0.
-func BeEmpty(actual interface{}, expected ...interface{}) error {
-	err := validateExpected(0, expected)
+func BeEmpty(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(0, expected)
 	if err != nil {
 		return err
 	}
@@ -27,8 +28,9 @@ func BeEmpty(actual interface{}, expected ...interface{}) error {
 }
 
 // BeEmpty (negated!)
-func (negated) BeEmpty(actual interface{}, expected ...interface{}) error {
-	err := BeEmpty(actual, expected...)
+func (negated) BeEmpty(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = BeEmpty(actual, expected...)
 	if errors.Is(err, ErrAssertionFailure) {
 		return nil
 	}
@@ -1,8 +1,9 @@
 package should
 
 // BeFalse verifies that actual is the boolean false value.
-func BeFalse(actual interface{}, expected ...interface{}) error {
-	err := validateExpected(0, expected)
+func BeFalse(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(0, expected)
 	if err != nil {
 		return err
 	}
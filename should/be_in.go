@@ -2,8 +2,9 @@ package should
 
 // BeIn determines whether actual is a member of expected[0].
 // It defers to Contain.
-func BeIn(actual interface{}, expected ...interface{}) error {
-	err := validateExpected(1, expected)
+func BeIn(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
 	if err != nil {
 		return err
 	}
@@ -17,8 +18,9 @@ func BeIn(actual interface{}, expected ...interface{}) error {
 }
 
 // BeIn (negated!)
-func (negated) BeIn(actual interface{}, expected ...interface{}) error {
-	err := validateExpected(1, expected)
+func (negated) BeIn(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
 	if err != nil {
 		return err
 	}
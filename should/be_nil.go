@@ -6,8 +6,9 @@ import (
 )
 
 // BeNil verifies that actual is the nil value.
-func BeNil(actual interface{}, expected ...interface{}) error {
-	err := validateExpected(0, expected)
+func BeNil(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(0, expected)
 	if err != nil {
 		return err
 	}
@@ -16,7 +17,7 @@ func BeNil(actual interface{}, expected ...interface{}) error {
 		return nil
 	}
 
-	return failure("got %#v, want <nil>", actual)
+	return failure("got %s, want <nil>", Format(actual))
 }
 func interfaceHasNilValue(actual interface{}) bool {
 	value := reflect.ValueOf(actual)
@@ -34,8 +35,9 @@ func interfaceHasNilValue(actual interface{}) bool {
 }
 
 // BeNil negated!
-func (negated) BeNil(actual interface{}, expected ...interface{}) error {
-	err := BeNil(actual, expected...)
+func (negated) BeNil(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = BeNil(actual, expected...)
 	if errors.Is(err, ErrAssertionFailure) {
 		return nil
 	}
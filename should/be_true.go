@@ -1,8 +1,9 @@
 package should
 
 // BeTrue verifies that actual is the boolean true value.
-func BeTrue(actual interface{}, expected ...interface{}) error {
-	err := validateExpected(0, expected)
+func BeTrue(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(0, expected)
 	if err != nil {
 		return err
 	}
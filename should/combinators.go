@@ -0,0 +1,261 @@
+package should
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Assertion is the shape shared by every assertion in this package
+// (and accepted by suite.T.So and assert.So).
+type Assertion = func(actual interface{}, expected ...interface{}) error
+
+// Bind fixes the expected values of the assertion, resulting in an
+// assertion which accepts no expected values of its own. It is most
+// useful when composing assertions with All, Any, Not, and Each:
+//
+//	So(5, should.All(should.Bind(should.BeGreaterThan, 1), should.Bind(should.BeLessThan, 10)))
+func Bind(assertion Assertion, expected ...interface{}) Assertion {
+	return func(actual interface{}, more ...interface{}) error {
+		err := validateExpected(0, more)
+		if err != nil {
+			return err
+		}
+
+		return assertion(actual, expected...)
+	}
+}
+
+// All combines the assertions into a single assertion which passes only
+// when every one of them passes. Each assertion receives the actual and
+// expected values provided to the combined assertion. Every failure is
+// reported along with the index of the assertion that failed.
+func All(assertions ...Assertion) Assertion {
+	return func(actual interface{}, expected ...interface{}) (err error) {
+		defer annotate(&err, actual, expected)
+		var failures []string
+		for x, assertion := range assertions {
+			err := assertion(actual, expected...)
+			if errors.Is(err, ErrAssertionFailure) {
+				failures = append(failures, describeFailure(fmt.Sprintf("assertion [%d]", x), err))
+			} else if err != nil {
+				return wrap(err, "assertion [%d]", x)
+			}
+		}
+		if len(failures) == 0 {
+			return nil
+		}
+
+		return failure("%d of %d assertions failed:\n%s",
+			len(failures),
+			len(assertions),
+			strings.Join(failures, "\n"),
+		)
+	}
+}
+
+// Any combines the assertions into a single assertion which passes when
+// at least one of them passes. Each assertion receives the actual and
+// expected values provided to the combined assertion. When none passes,
+// each failure is reported along with the index of its assertion.
+func Any(assertions ...Assertion) Assertion {
+	return func(actual interface{}, expected ...interface{}) (err error) {
+		defer annotate(&err, actual, expected)
+		var failures []string
+		for x, assertion := range assertions {
+			err := assertion(actual, expected...)
+			if err == nil {
+				return nil
+			}
+			if !errors.Is(err, ErrAssertionFailure) {
+				return wrap(err, "assertion [%d]", x)
+			}
+			failures = append(failures, describeFailure(fmt.Sprintf("assertion [%d]", x), err))
+		}
+
+		return failure("none of %d assertions passed:\n%s",
+			len(assertions),
+			strings.Join(failures, "\n"),
+		)
+	}
+}
+
+// Not negates the assertion, which may be any assertion (not only those
+// of this package provided via NOT). As with NOT, errors other than
+// assertion failures (such as ErrKindMismatch) are returned as-is.
+func Not(assertion Assertion) Assertion {
+	return func(actual interface{}, expected ...interface{}) (err error) {
+		defer annotate(&err, actual, expected)
+		err = assertion(actual, expected...)
+		if errors.Is(err, ErrAssertionFailure) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(expected) == 0 {
+			return failure("\n"+
+				"  negated assertion passed\n"+
+				"  actual: %s",
+				Format(actual),
+			)
+		}
+
+		return failure("\n"+
+			"  negated assertion passed\n"+
+			"  actual:   %s\n"+
+			"  expected: %s",
+			Format(actual),
+			Format(expected),
+		)
+	}
+}
+
+// Each results in an assertion which applies the provided assertion (and
+// expected values) to each element of the array, slice, or map (values)
+// provided as actual. Every failure is reported along with the index (or
+// key) of the element that failed. Empty collections satisfy Each.
+func Each(assertion Assertion, expected ...interface{}) Assertion {
+	return func(actual interface{}, more ...interface{}) (err error) {
+		defer annotate(&err, actual, more)
+		err = validateExpected(0, more)
+		if err != nil {
+			return err
+		}
+
+		err = validateKind(actual, reflect.Array, reflect.Slice, reflect.Map)
+		if err != nil {
+			return err
+		}
+
+		var failures []string
+		for _, element := range elementsWithPaths(reflect.ValueOf(actual)) {
+			err = assertion(element.value.Interface(), expected...)
+			if errors.Is(err, ErrAssertionFailure) {
+				failures = append(failures, describeFailure("element "+element.path, err))
+			} else if err != nil {
+				return wrap(err, "element %s", element.path)
+			}
+		}
+		if len(failures) == 0 {
+			return nil
+		}
+
+		return failure("%d of %d elements failed:\n%s",
+			len(failures),
+			reflect.ValueOf(actual).Len(),
+			strings.Join(failures, "\n"),
+		)
+	}
+}
+
+// Satisfy results in an assertion which passes when the predicate, a
+// func(T) bool, returns true for the actual value (which must therefore
+// be assignable to T). The description names the property the predicate
+// verifies and is included in failure reports.
+func Satisfy(predicate interface{}, description string) Assertion {
+	return func(actual interface{}, expected ...interface{}) (err error) {
+		defer annotate(&err, actual, expected)
+		err = validateExpected(0, expected)
+		if err != nil {
+			return err
+		}
+
+		typ := reflect.TypeOf(predicate)
+		if typ == nil || typ.Kind() != reflect.Func || typ.IsVariadic() ||
+			typ.NumIn() != 1 || typ.NumOut() != 1 || typ.Out(0).Kind() != reflect.Bool {
+			return wrap(ErrTypeMismatch, "got %v, want func(T) bool", typ)
+		}
+
+		input, err := predicateInput(actual, typ.In(0))
+		if err != nil {
+			return err
+		}
+
+		if reflect.ValueOf(predicate).Call([]reflect.Value{input})[0].Bool() {
+			return nil
+		}
+
+		return failure("\n"+
+			"  unsatisfied: %s\n"+
+			"  actual:      %s",
+			description,
+			Format(actual),
+		)
+	}
+}
+
+func predicateInput(actual interface{}, typ reflect.Type) (reflect.Value, error) {
+	if actual == nil {
+		switch typ.Kind() {
+		case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+			return reflect.Zero(typ), nil
+		}
+		return reflect.Value{}, wrap(ErrTypeMismatch, "got <nil>, want %v", typ)
+	}
+	value := reflect.ValueOf(actual)
+	if !value.Type().AssignableTo(typ) {
+		return reflect.Value{}, wrap(ErrTypeMismatch, "got %v, want %v", value.Type(), typ)
+	}
+	return value, nil
+}
+
+// pathElement is an element of a collection, along with
+// its location (index or key) within that collection.
+type pathElement struct {
+	path  string
+	value reflect.Value
+}
+
+func elementsWithPaths(collection reflect.Value) (elements []pathElement) {
+	if collection.Kind() == reflect.Map {
+		for _, key := range sortedKeys(collection) {
+			elements = append(elements, pathElement{
+				path:  "[" + formatLine(key) + "]",
+				value: collection.MapIndex(key),
+			})
+		}
+		return elements
+	}
+	for x := 0; x < collection.Len(); x++ {
+		elements = append(elements, pathElement{
+			path:  fmt.Sprintf("[%d]", x),
+			value: collection.Index(x),
+		})
+	}
+	return elements
+}
+
+// describeFailure labels the (indented) message of a nested assertion failure.
+func describeFailure(label string, err error) string {
+	report := strings.TrimPrefix(err.Error(), ErrAssertionFailure.Error()+": ")
+	return "  " + label + ":\n" + indent(dedent(strings.TrimLeft(report, "\n")), "    ")
+}
+
+// dedent removes the leading whitespace common to every (non-blank) line.
+func dedent(s string) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	common := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		width := len(line) - len(strings.TrimLeft(line, " \t"))
+		if common < 0 || width < common {
+			common = width
+		}
+	}
+	for x, line := range lines {
+		if len(line) >= common && common > 0 {
+			lines[x] = line[common:]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func indent(s, prefix string) string {
+	return prefix + strings.Replace(s, "\n", "\n"+prefix, -1)
+}
@@ -0,0 +1,156 @@
+package should_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mdwhatcott/testing/assert"
+	"github.com/mdwhatcott/testing/should"
+)
+
+var (
+	positive = should.Bind(should.BeGreaterThan, 0)
+	even     = should.Satisfy(func(n int) bool { return n%2 == 0 }, "even")
+)
+
+func TestShouldBind(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1, should.Bind(should.Equal, 1), "EXTRA")
+	assert.ExpectedCountInvalid(1, should.Bind(should.Equal))
+
+	assert.Pass(1, should.Bind(should.Equal, 1))
+	assert.Fail(2, should.Bind(should.Equal, 1))
+}
+
+func TestShouldAll(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.Pass(4, should.All())
+	assert.Pass(4, should.All(positive, even))
+	assert.Fail(3, should.All(positive, even))
+	assert.Fail(-2, should.All(positive, even))
+	assert.Pass(4, should.All(should.Equal, should.BeGreaterThanOrEqualTo), 4)
+	assert.KindMismatch(1, should.All(positive, should.Bind(should.HaveLength, 1)))
+	assert.TypeMismatch("a", should.All(even))
+}
+
+func TestShouldAllReportsIndexOfFailedAssertion(t *testing.T) {
+	err := should.All(positive, even, should.Bind(should.BeLessThan, 2))(3)
+
+	assertReportContains(t, err,
+		"2 of 3 assertions failed",
+		"assertion [1]:\n    unsatisfied: even",
+		"assertion [2]:",
+	)
+	if strings.Contains(err.Error(), "assertion [0]") {
+		t.Error("passing assertions should not be reported:", err)
+	}
+}
+
+func TestShouldAny(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.Fail(4, should.Any())
+	assert.Pass(3, should.Any(positive, even))
+	assert.Pass(-2, should.Any(positive, even))
+	assert.Fail(-3, should.Any(positive, even))
+	assert.Pass(4, should.Any(should.BeLessThan, should.Equal), 4)
+	assert.TypeMismatch("a", should.Any(even))
+}
+
+func TestShouldAnyReportsEachFailure(t *testing.T) {
+	err := should.Any(positive, even)(-3)
+
+	assertReportContains(t, err,
+		"none of 2 assertions passed",
+		"assertion [0]:",
+		"assertion [1]:\n    unsatisfied: even",
+	)
+}
+
+func TestShouldNot(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.Pass(3, should.Not(even))
+	assert.Fail(4, should.Not(even))
+	assert.Pass(1, should.Not(should.Equal), 2)
+	assert.Fail(1, should.Not(should.Equal), 1)
+	assert.Pass(1, should.Not(should.Not(should.Equal)), 1)
+	assert.KindMismatch(1, should.Not(should.HaveLength), 1)
+}
+
+func TestShouldNotAcceptsNamedAssertions(t *testing.T) {
+	var named assert.Assertion = should.Equal
+
+	err := assert.So(1, should.Not(named), 2)
+
+	if err != nil {
+		t.Error("unexpected error:", err)
+	}
+}
+
+func TestShouldEach(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid([]int{1}, should.Each(should.Equal, 1), "EXTRA")
+	assert.KindMismatch(1, should.Each(should.Equal, 1))
+
+	assert.Pass([]int{}, should.Each(should.Equal, 1))
+	assert.Pass([]int{1, 1}, should.Each(should.Equal, 1))
+	assert.Pass([2]int{2, 4}, should.Each(even))
+	assert.Pass(map[string]int{"a": 2}, should.Each(even))
+	assert.Fail([]int{2, 3}, should.Each(even))
+	assert.Fail(map[string]int{"a": 3}, should.Each(even))
+	assert.Pass([][]int{{1}, {2}}, should.Each(should.HaveLength, 1))
+	assert.KindMismatch([]int{1}, should.Each(should.HaveLength, 1))
+}
+
+func TestShouldEachReportsIndexOfFailedElements(t *testing.T) {
+	err := should.Each(even)([]int{2, 3, 4, 5})
+
+	assertReportContains(t, err,
+		"2 of 4 elements failed",
+		"element [1]:\n    unsatisfied: even\n    actual:      3",
+		"element [3]:\n    unsatisfied: even\n    actual:      5",
+	)
+}
+
+func TestShouldEachReportsKeysOfFailedMapValues(t *testing.T) {
+	err := should.Each(positive)(map[string]int{"a": 1, "b": -1})
+
+	assertReportContains(t, err, `element ["b"]:`)
+}
+
+func TestShouldEachVisitsEntriesWithKeysFormattedAlike(t *testing.T) {
+	a, b := 1, 1
+	err := should.Each(positive)(map[*int]int{&a: 1, &b: -1})
+
+	assertReportContains(t, err, "1 of 2 elements failed", "element [&1]:")
+}
+
+func TestShouldEachWrapsErrorsWithIndex(t *testing.T) {
+	err := should.Each(should.HaveLength, 1)([]interface{}{"a", 1})
+
+	if !errors.Is(err, should.ErrKindMismatch) {
+		t.Fatal("expected kind mismatch, got:", err)
+	}
+	assertReportContains(t, err, "element [1]")
+}
+
+func TestShouldSatisfy(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(2, even, "EXTRA")
+	assert.TypeMismatch("2", even)
+	assert.TypeMismatch(2, should.Satisfy(func(int) {}, "nothing"))
+	assert.TypeMismatch(2, should.Satisfy(nil, "nothing"))
+	assert.TypeMismatch(nil, even)
+
+	assert.Pass(2, even)
+	assert.Fail(3, even)
+	assert.Pass(nil, should.Satisfy(func(err error) bool { return err == nil }, "nil error"))
+	assert.Pass(errors.New("x"), should.Satisfy(func(err error) bool { return err != nil }, "non-nil error"))
+	assert.Pass("abc", should.Satisfy(func(v interface{}) bool { return v == "abc" }, "abc"))
+}
@@ -11,8 +11,9 @@ import (
 //   - In the case of maps the expected value is assumed to be a map key.
 //   - In the case of slices and arrays the expected value is assumed to be a member.
 //   - In the case of strings the expected value may be a rune or substring.
-func Contain(actual interface{}, expected ...interface{}) error {
-	err := validateExpected(1, expected)
+func Contain(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
 	if err != nil {
 		return err
 	}
@@ -35,7 +36,7 @@ func Contain(actual interface{}, expected ...interface{}) error {
 	case reflect.Array, reflect.Slice:
 		for i := 0; i < actualValue.Len(); i++ {
 			item := actualValue.Index(i).Interface()
-			if Equal(EXPECTED, item) == nil {
+			if equal(EXPECTED, item) {
 				return nil
 			}
 		}
@@ -58,16 +59,17 @@ func Contain(actual interface{}, expected ...interface{}) error {
 	}
 
 	return failure("\n"+
-		"   item absent: %#v\n"+
-		"   within:      %#v",
-		EXPECTED,
-		actual,
+		"   item absent: %s\n"+
+		"   within:      %s",
+		Format(EXPECTED),
+		Format(actual),
 	)
 }
 
 // Contain (negated!)
-func (negated) Contain(actual interface{}, expected ...interface{}) error {
-	err := Contain(actual, expected...)
+func (negated) Contain(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = Contain(actual, expected...)
 	if errors.Is(err, ErrAssertionFailure) {
 		return nil
 	}
@@ -77,10 +79,10 @@ func (negated) Contain(actual interface{}, expected ...interface{}) error {
 	}
 
 	return failure("\n"+
-		"item found: %#v\n"+
-		"within:     %#v",
-		expected[0],
-		actual,
+		"item found: %s\n"+
+		"within:     %s",
+		Format(expected[0]),
+		Format(actual),
 	)
 }
 
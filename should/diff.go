@@ -0,0 +1,403 @@
+package should
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// difference describes a single location (path) at which
+// the actual and expected values disagree.
+type difference struct {
+	path     string
+	actual   string
+	expected string
+}
+
+func (this difference) String() string {
+	return fmt.Sprintf("%s: %s != %s", this.path, this.actual, this.expected)
+}
+
+// differ walks two values in parallel and collects
+// the location of each difference it encounters.
+type differ struct {
+	options     *equalityOptions
+	specs       []Specification
+	differences []difference
+	visited     map[visit]bool
+	deciding    bool // stop at the first difference, without describing it
+}
+
+// visit identifies a pair of pointers already being
+// compared, allowing the differ to cope with cycles.
+type visit struct {
+	actual   uintptr
+	expected uintptr
+	typ      reflect.Type
+}
+
+func newDiffer(options *equalityOptions) *differ {
+	if options == nil {
+		options = new(equalityOptions)
+	}
+	return &differ{
+		options: options,
+		specs:   nestedSpecifications(),
+		visited: make(map[visit]bool),
+	}
+}
+
+// differences lists each location at which actual and
+// expected differ, described relative to the root value.
+func differences(actual, expected interface{}, options *equalityOptions) []difference {
+	differ := newDiffer(options)
+	differ.diff("", "", reflect.ValueOf(actual), reflect.ValueOf(expected))
+	return differ.differences
+}
+
+// equivalent reports whether actual and expected are equal (that is,
+// whether they have no differences) without describing any differences.
+func equivalent(actual, expected interface{}, options *equalityOptions) bool {
+	differ := newDiffer(options)
+	differ.deciding = true
+	differ.diff("", "", reflect.ValueOf(actual), reflect.ValueOf(expected))
+	return len(differ.differences) == 0
+}
+
+// record notes a difference located at path, which is described
+// (via the describe func) unless the differ is only deciding.
+func (this *differ) record(path string, describe func() (actual, expected string)) {
+	if this.deciding {
+		this.differences = append(this.differences, difference{path: path})
+		return
+	}
+	if path == "" {
+		path = "(root)"
+	}
+	actual, expected := describe()
+	this.differences = append(this.differences, difference{
+		path:     path,
+		actual:   actual,
+		expected: expected,
+	})
+}
+
+// decided reports whether the differ, only deciding, has found a difference.
+func (this *differ) decided() bool {
+	return this.deciding && len(this.differences) > 0
+}
+
+// formatted describes a difference between the values by formatting each.
+func formatted(actual, expected reflect.Value) func() (string, string) {
+	return func() (string, string) { return formatValue(actual), formatValue(expected) }
+}
+
+// absentFrom describes a difference between a value and its absence,
+// from actual (if missing is true) or else from expected.
+func absentFrom(missing bool, value reflect.Value) func() (string, string) {
+	return func() (string, string) {
+		if missing {
+			return absent, formatValue(value)
+		}
+		return formatValue(value), absent
+	}
+}
+
+// diff compares actual and expected, which are located at path. The
+// fieldPath names only the struct fields traversed to reach them (for
+// matching against the fields ignored by the equalityOptions).
+func (this *differ) diff(path, fieldPath string, actual, expected reflect.Value) {
+	if this.decided() {
+		return
+	}
+	if !actual.IsValid() || !expected.IsValid() {
+		if actual.IsValid() != expected.IsValid() {
+			this.record(path, formatted(actual, expected))
+		}
+		return
+	}
+	actual, expected = addressable(actual), addressable(expected)
+
+	if equal, ok := this.compare(actual, expected); ok {
+		if !equal {
+			this.record(path, formatted(actual, expected))
+		}
+		return
+	}
+
+	if actual.Type() != expected.Type() {
+		this.record(path, func() (string, string) {
+			return fmt.Sprintf("(%v) %s", actual.Type(), formatValue(actual)),
+				fmt.Sprintf("(%v) %s", expected.Type(), formatValue(expected))
+		})
+		return
+	}
+
+	switch actual.Kind() {
+	case reflect.Ptr:
+		if actual.IsNil() || expected.IsNil() {
+			if actual.IsNil() != expected.IsNil() {
+				this.record(path, formatted(actual, expected))
+			}
+			return
+		}
+		if actual.Pointer() == expected.Pointer() || this.seen(actual, expected) {
+			return
+		}
+		this.diff(path, fieldPath, actual.Elem(), expected.Elem())
+
+	case reflect.Interface:
+		if actual.IsNil() || expected.IsNil() {
+			if actual.IsNil() != expected.IsNil() {
+				this.record(path, formatted(actual, expected))
+			}
+			return
+		}
+		this.diff(path, fieldPath, actual.Elem(), expected.Elem())
+
+	case reflect.Struct:
+		for x := 0; x < actual.NumField(); x++ {
+			field := actual.Type().Field(x)
+			name := strings.TrimPrefix(fieldPath+"."+field.Name, ".")
+			if this.options.ignores(field, name) {
+				continue
+			}
+			this.diff(path+"."+field.Name, name, actual.Field(x), expected.Field(x))
+		}
+
+	case reflect.Map:
+		if this.options.equateEmpty && actual.Len() == 0 && expected.Len() == 0 {
+			return
+		}
+		if actual.IsNil() != expected.IsNil() {
+			this.record(path, formatted(actual, expected))
+			return
+		}
+		if this.seen(actual, expected) {
+			return
+		}
+		this.diffMap(path, fieldPath, actual, expected)
+
+	case reflect.Slice:
+		if this.options.equateEmpty && actual.Len() == 0 && expected.Len() == 0 {
+			return
+		}
+		if actual.IsNil() != expected.IsNil() {
+			this.record(path, formatted(actual, expected))
+			return
+		}
+		if this.seen(actual, expected) {
+			return
+		}
+		actual, expected = this.options.sort(actual), this.options.sort(expected)
+		this.diffSequence(path, fieldPath, actual, expected)
+
+	case reflect.Array:
+		this.diffSequence(path, fieldPath, actual, expected)
+
+	default:
+		if !scalarsEqual(actual, expected) {
+			this.record(path, formatted(actual, expected))
+		}
+	}
+}
+
+// compare applies the first custom comparison (from the options) or
+// specification that pertains to the values, reporting the outcome
+// and whether any such comparison was applied. Specifications can
+// only be applied to values that are accessible (ie. not obtained
+// via unexported struct fields), with the exception of time.Time
+// values, which are always compared as instants (where addressable).
+func (this *differ) compare(actual, expected reflect.Value) (equal, ok bool) {
+	if actual.Type() == expected.Type() {
+		if equal, ok = this.options.compare(actual, expected); ok {
+			return equal, ok
+		}
+	}
+	if actual.Type() == timeType && expected.Type() == timeType {
+		actual, expected = accessible(actual), accessible(expected)
+	}
+	if !actual.CanInterface() || !expected.CanInterface() {
+		return false, false
+	}
+	a, b := actual.Interface(), expected.Interface()
+	for _, spec := range this.specs {
+		if spec.IsSatisfiedBy(a, b) {
+			return spec.AreEqual(a, b), true
+		}
+	}
+	return false, false
+}
+
+// diffMap compares the entries of the maps, matching keys by identity
+// (as would the map itself) and never by their formatted representation,
+// which may be shared by distinct keys (such as pointers to equal values).
+func (this *differ) diffMap(path, fieldPath string, actual, expected reflect.Value) {
+	for _, key := range sortedKeys(actual) {
+		keyPath := path + "[" + formatLine(key) + "]"
+		expectedValue := expected.MapIndex(key)
+		if !expectedValue.IsValid() {
+			this.record(keyPath, absentFrom(false, actual.MapIndex(key)))
+			continue
+		}
+		this.diff(keyPath, fieldPath, actual.MapIndex(key), expectedValue)
+	}
+	for _, key := range sortedKeys(expected) {
+		if !actual.MapIndex(key).IsValid() {
+			this.record(path+"["+formatLine(key)+"]", absentFrom(true, expected.MapIndex(key)))
+		}
+	}
+}
+
+func (this *differ) diffSequence(path, fieldPath string, actual, expected reflect.Value) {
+	for x := 0; x < actual.Len() || x < expected.Len(); x++ {
+		indexPath := fmt.Sprintf("%s[%d]", path, x)
+		switch {
+		case x >= actual.Len():
+			this.record(indexPath, absentFrom(true, expected.Index(x)))
+		case x >= expected.Len():
+			this.record(indexPath, absentFrom(false, actual.Index(x)))
+		default:
+			this.diff(indexPath, fieldPath, actual.Index(x), expected.Index(x))
+		}
+	}
+}
+
+// addressable copies structs and arrays which are not addressable (but
+// are accessible) so that the values of their fields (notably time.Time
+// values held by unexported fields) can be made accessible when compared.
+func addressable(value reflect.Value) reflect.Value {
+	if value.CanAddr() || !value.CanInterface() {
+		return value
+	}
+	if kind := value.Kind(); kind != reflect.Struct && kind != reflect.Array {
+		return value
+	}
+	copied := reflect.New(value.Type()).Elem()
+	copied.Set(value)
+	return copied
+}
+
+// seen reports whether the pair of (pointer-like) values has
+// already been visited, marking it as visited if not.
+func (this *differ) seen(actual, expected reflect.Value) bool {
+	key := visit{
+		actual:   actual.Pointer(),
+		expected: expected.Pointer(),
+		typ:      actual.Type(),
+	}
+	if this.visited[key] {
+		return true
+	}
+	this.visited[key] = true
+	return false
+}
+
+// scalarsEqual compares values of identical type which are neither
+// containers nor pointers. Comparisons are made via the kind-specific
+// accessors so that unexported struct fields may also be inspected.
+func scalarsEqual(actual, expected reflect.Value) bool {
+	switch actual.Kind() {
+	case reflect.Bool:
+		return actual.Bool() == expected.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return actual.Int() == expected.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return actual.Uint() == expected.Uint()
+	case reflect.Float32, reflect.Float64:
+		return actual.Float() == expected.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return actual.Complex() == expected.Complex()
+	case reflect.String:
+		return actual.String() == expected.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return actual.Pointer() == expected.Pointer()
+	case reflect.Func:
+		return actual.IsNil() && expected.IsNil() // same as reflect.DeepEqual
+	default:
+		return false
+	}
+}
+
+// sortedKeys gathers the keys of the map, ordered as by compareKeys.
+// (Distinct keys may be formatted alike, so keys are never identified
+// by their formatted representation, which serves only for display.)
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return compareKeys(keys[i], keys[j]) < 0
+	})
+	return keys
+}
+
+// compareKeys orders map keys: numbers (of any kind) by value, then
+// strings, then any other keys by their formatted representation.
+func compareKeys(a, b reflect.Value) int {
+	a, b = concrete(a), concrete(b)
+	if rankA, rankB := keyRank(a), keyRank(b); rankA != rankB {
+		return rankA - rankB
+	}
+	switch keyRank(a) {
+	case numericKeys:
+		if comparison := compareNumerics(a, b); comparison != unordered {
+			return comparison
+		}
+	case stringKeys:
+		return strings.Compare(a.String(), b.String())
+	}
+	return strings.Compare(formatValue(a), formatValue(b))
+}
+
+const (
+	numericKeys = iota
+	stringKeys
+	otherKeys
+)
+
+func keyRank(key reflect.Value) int {
+	if _, numeric := numericKinds[key.Kind()]; numeric {
+		return numericKeys
+	}
+	if key.Kind() == reflect.String {
+		return stringKeys
+	}
+	return otherKeys
+}
+
+// concrete unwraps the value held by a (non-nil) interface value.
+func concrete(value reflect.Value) reflect.Value {
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		return value.Elem()
+	}
+	return value
+}
+
+func formatValue(value reflect.Value) string {
+	if !value.IsValid() {
+		return "<nil>"
+	}
+	return formatLine(value)
+}
+
+func isComposite(v interface{}) bool {
+	if v == nil || isTime(v) {
+		return false
+	}
+	switch reflect.TypeOf(v).Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Ptr:
+		return true
+	default:
+		return false
+	}
+}
+
+func formatDifferences(differences []difference) string {
+	lines := make([]string, 0, len(differences))
+	for _, d := range differences {
+		lines = append(lines, "  "+d.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+const absent = "<absent>"
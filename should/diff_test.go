@@ -0,0 +1,78 @@
+package should_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestEqualReportsDifferencesByPath(t *testing.T) {
+	type Line struct{ Qty int }
+	type Order struct{ Lines map[string]Line }
+	type Customer struct {
+		Name   string
+		Orders []Order
+	}
+
+	actual := Customer{
+		Name: "Alice",
+		Orders: []Order{
+			{Lines: map[string]Line{"sku": {Qty: 1}}},
+			{Lines: map[string]Line{"sku": {Qty: 2}, "extra": {}}},
+		},
+	}
+	expected := Customer{
+		Name: "Bob",
+		Orders: []Order{
+			{Lines: map[string]Line{"sku": {Qty: 1}}},
+			{Lines: map[string]Line{"sku": {Qty: 3}}},
+			{},
+		},
+	}
+
+	err := should.Equal(actual, expected)
+
+	assertReportContains(t, err,
+		`.Name: "Alice" != "Bob"`,
+		`.Orders[1].Lines["sku"].Qty: 2 != 3`,
+		`.Orders[1].Lines["extra"]: should_test.Line{Qty: 0} != <absent>`,
+		`.Orders[2]: <absent> != should_test.Order{Lines: map[string]should_test.Line(nil)}`,
+	)
+}
+
+func TestEqualReportsCharacterDiffForScalars(t *testing.T) {
+	err := should.Equal("hello", "hallo")
+
+	assertReportContains(t, err, "  ^")
+	if strings.Contains(err.Error(), "Differences") {
+		t.Error("scalars should not be reported with structural differences:", err)
+	}
+}
+
+func TestEqualReportsDifferencesOfCyclicValues(t *testing.T) {
+	type Node struct {
+		Value int
+		Next  *Node
+	}
+	a := &Node{Value: 1}
+	a.Next = a
+	b := &Node{Value: 2}
+	b.Next = b
+
+	err := should.Equal(a, b)
+
+	assertReportContains(t, err, ".Value: 1 != 2")
+}
+
+func assertReportContains(t *testing.T, err error, fragments ...string) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected a failure report, got <nil>")
+	}
+	for _, fragment := range fragments {
+		if !strings.Contains(err.Error(), fragment) {
+			t.Errorf("report missing fragment:\n%s\nreport:\n%s", fragment, err)
+		}
+	}
+}
@@ -1,14 +1,19 @@
 package should
 
 import (
+	"errors"
 	"reflect"
 	"strings"
 )
 
 // EndWith verifies that actual ends with expected[0].
-// The actual value may be an array, slice, or string.
-func EndWith(actual interface{}, expected ...interface{}) error {
-	err := validateExpected(1, expected)
+// The actual value may be an array, slice, or string. When actual is an
+// array or slice, expected[0] may be a single element or (being an array
+// or slice itself) a sequence of elements with which actual ends, unless
+// the elements of actual are themselves arrays or slices.
+func EndWith(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
 	if err != nil {
 		return err
 	}
@@ -23,11 +28,17 @@ func EndWith(actual interface{}, expected ...interface{}) error {
 
 	switch reflect.TypeOf(actual).Kind() {
 	case reflect.Array, reflect.Slice:
+		if isSequenceOf(actualValue, EXPECTED) {
+			if sequenceAt(actualValue, reflect.ValueOf(EXPECTED), actualValue.Len()-reflect.ValueOf(EXPECTED).Len()) {
+				return nil
+			}
+			break
+		}
 		if actualValue.Len() == 0 {
 			break
 		}
 		last := actualValue.Index(actualValue.Len() - 1).Interface()
-		if Equal(EXPECTED, last) == nil {
+		if equal(EXPECTED, last) {
 			return nil
 		}
 	case reflect.String:
@@ -42,16 +53,36 @@ func EndWith(actual interface{}, expected ...interface{}) error {
 		}
 
 		full := actual.(string)
-		prefix := EXPECTED.(string)
-		if strings.HasSuffix(full, prefix) {
+		suffix := EXPECTED.(string)
+		if strings.HasSuffix(full, suffix) {
 			return nil
 		}
 	}
 
 	return failure("\n"+
-		"   proposed prefix: %#v\n"+
-		"   not a prefix of: %#v",
-		EXPECTED,
-		actual,
+		"   proposed suffix: %s\n"+
+		"   not a suffix of: %s",
+		Format(EXPECTED),
+		Format(actual),
+	)
+}
+
+// EndWith (negated!)
+func (negated) EndWith(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = EndWith(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("\n"+
+		"   suffix found: %s\n"+
+		"   within:       %s",
+		Format(expected[0]),
+		Format(actual),
 	)
 }
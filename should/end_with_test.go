@@ -32,3 +32,36 @@ func TestShouldEndWith(t *testing.T) {
 	assert.Pass([3]byte{'a', 'b', 'c'}, should.EndWith, 'c')
 	assert.Pass([3]byte{'a', 'b', 'c'}, should.EndWith, 99)
 }
+
+func TestShouldEndWithSequence(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.Pass([]int{1, 2, 3}, should.EndWith, []int{2, 3})
+	assert.Pass([]int{1, 2, 3}, should.EndWith, [2]int64{2, 3})
+	assert.Pass([]int{1, 2, 3}, should.EndWith, []int{})
+	assert.Pass([3]int{1, 2, 3}, should.EndWith, []int{1, 2, 3})
+	assert.Fail([]int{1, 2, 3}, should.EndWith, []int{1, 2})
+	assert.Fail([]int{2, 3}, should.EndWith, []int{1, 2, 3})
+
+	// nested:
+	assert.Pass([][]int{{1}, {2, 3}}, should.EndWith, []int{2, 3})
+	assert.Fail([][]int{{1}, {2, 3}}, should.EndWith, []int{3})
+}
+
+func TestShouldNotEndWith(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid("actual", should.NOT.EndWith)
+	assert.KindMismatch(1, should.NOT.EndWith, "hi")
+
+	assert.Fail("integrate", should.NOT.EndWith, "ate")
+	assert.Pass("integrate", should.NOT.EndWith, "in")
+	assert.Fail([]int{1, 2, 3}, should.NOT.EndWith, []int{2, 3})
+	assert.Pass([]int{1, 2, 3}, should.NOT.EndWith, 2)
+}
+
+func TestShouldEndWithReportsSuffix(t *testing.T) {
+	err := should.EndWith("integrate", "in")
+
+	assertReportContains(t, err, "proposed suffix", "not a suffix of")
+}
@@ -5,38 +5,48 @@ import (
 	"fmt"
 	"math"
 	"reflect"
-	"runtime/debug"
 	"strings"
 	"time"
 )
 
 // Equal verifies that the actual value is equal to the expected value.
 // It uses reflect.DeepEqual in most cases, but also compares numerics
-// regardless of specific type and compares time.Time values using the
-// time.Equal method.
-func Equal(actual interface{}, EXPECTED ...interface{}) error {
-	err := validateExpected(1, EXPECTED)
+// regardless of specific type, compares time.Time values using the
+// time.Equal method, and compares values of any type with a method of
+// the form `Equal(T) bool` using that method. These rules are applied at
+// every level of composite values (pointers, structs, maps, slices, and
+// arrays), and additional rules may be provided via RegisterSpecification.
+func Equal(actual interface{}, EXPECTED ...interface{}) (err error) {
+	defer annotate(&err, actual, EXPECTED)
+	err = validateExpected(1, EXPECTED)
 	if err != nil {
 		return err
 	}
 
 	expected := EXPECTED[0]
 
-	for _, spec := range specs {
-		if !spec.isSatisfiedBy(actual, expected) {
-			continue
-		}
-		if spec.areEqual(actual, expected) {
-			return nil
+	if equal(actual, expected) {
+		return nil
+	}
+	return diffFailure(equalityDiff(actual, expected, nil), "%s", report(actual, expected, nil))
+}
+
+// equal reports whether the values are equal, as decided by Equal,
+// but without the expense of reporting (and locating) any failure,
+// for assertions which compare many values.
+func equal(actual, expected interface{}) bool {
+	for _, spec := range specifications() {
+		if spec.IsSatisfiedBy(actual, expected) {
+			return spec.AreEqual(actual, expected)
 		}
-		break
 	}
-	return failure(report(actual, expected))
+	return false
 }
 
 // Equal negated!
-func (negated) Equal(actual interface{}, expected ...interface{}) error {
-	err := Equal(actual, expected...)
+func (negated) Equal(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = Equal(actual, expected...)
 	if errors.Is(err, ErrAssertionFailure) {
 		return nil
 	}
@@ -45,47 +55,81 @@ func (negated) Equal(actual interface{}, expected ...interface{}) error {
 		return err
 	}
 
+	if isMultiLine(actual, expected[0]) {
+		return failure("\n"+
+			"  expected multi-line strings to differ, but both were:\n%s",
+			numberedLines(actual.(string)),
+		)
+	}
+
 	return failure("\n"+
-		"  expected:     %#v\n"+
-		"  to not equal: %#v\n"+
+		"  expected:     %s\n"+
+		"  to not equal: %s\n"+
 		"  (but it did)",
-		expected[0],
-		actual,
+		Format(expected[0]),
+		Format(actual),
 	)
 }
 
-var specs = []specification{
+var builtinSpecs = []Specification{
 	numericEquality{},
 	timeEquality{},
+	equalMethodEquality{},
 	deepEquality{},
 }
 
-func report(a, b interface{}) string {
+func report(a, b interface{}, options *equalityOptions) string {
+	if isMultiLine(a, b) {
+		return multiLineReport(a.(string), b.(string))
+	}
+
 	aType := fmt.Sprintf("(%v)", reflect.TypeOf(a))
 	bType := fmt.Sprintf("(%v)", reflect.TypeOf(b))
 	longestType := int(math.Max(float64(len(aType)), float64(len(bType))))
 	aType += strings.Repeat(" ", longestType-len(aType))
 	bType += strings.Repeat(" ", longestType-len(bType))
-	aFormat := fmt.Sprintf(format(a), a)
-	bFormat := fmt.Sprintf(format(b), b)
-	typeDiff := diff(bType, aType)
-	valueDiff := diff(bFormat, aFormat)
+	aFormat := Format(a)
+	bFormat := Format(b)
 
 	builder := new(strings.Builder)
 	_, _ = fmt.Fprintf(builder, "\n")
 	_, _ = fmt.Fprintf(builder, "Expected: %s %s\n", bType, bFormat)
 	_, _ = fmt.Fprintf(builder, "Actual  : %s %s\n", aType, aFormat)
-	_, _ = fmt.Fprintf(builder, "          %s %s\n", typeDiff, valueDiff)
-	_, _ = fmt.Fprintf(builder, "Stack (filtered):\n%s\n", stack())
+	if differences := compositeDifferences(a, b, options); len(differences) > 0 {
+		_, _ = fmt.Fprintf(builder, "Differences (actual != expected):\n%s\n", formatDifferences(differences))
+	} else if !strings.Contains(aFormat+bFormat, "\n") {
+		_, _ = fmt.Fprintf(builder, "          %s %s\n", diff(bType, aType), diff(bFormat, aFormat))
+	}
 
-	return builder.String()
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+func multiLineReport(a, b string) string {
+	builder := new(strings.Builder)
+	_, _ = fmt.Fprintf(builder, "\n")
+	_, _ = fmt.Fprintf(builder, "Expected: (string) %s\n", countLines(b))
+	_, _ = fmt.Fprintf(builder, "Actual  : (string) %s\n", countLines(a))
+	_, _ = fmt.Fprintf(builder, "Diff:\n%s\n", unifiedDiff(b, a))
+	return strings.TrimSuffix(builder.String(), "\n")
 }
-func format(v interface{}) string {
-	if isNumeric(v) || isTime(v) {
-		return "%v"
-	} else {
-		return "%#v"
+
+// equalityDiff renders a line-oriented diff of multi-line strings,
+// or the differences between composite values (or else nothing).
+func equalityDiff(a, b interface{}, options *equalityOptions) string {
+	if isMultiLine(a, b) {
+		return unifiedDiff(b.(string), a.(string))
 	}
+	return formatDifferences(compositeDifferences(a, b, options))
+}
+
+// compositeDifferences lists the locations at which two composite
+// values of the same type differ. Scalars (and values of differing
+// types) are better served by the character-level diff.
+func compositeDifferences(a, b interface{}, options *equalityOptions) []difference {
+	if !isComposite(a) || reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return nil
+	}
+	return differences(a, b, options)
 }
 func diff(a, b string) string {
 	result := new(strings.Builder)
@@ -102,33 +146,22 @@ func diff(a, b string) string {
 	}
 	return result.String()
 }
-func stack() string {
-	lines := strings.Split(string(debug.Stack()), "\n")
-	var filtered []string
-	for x := 1; x < len(lines)-1; x += 2 {
-		if strings.Contains(lines[x+1], "_test.go:") {
-			filtered = append(filtered, lines[x], lines[x+1])
-		}
-	}
-	return "> " + strings.Join(filtered, "\n> ")
-}
 
 type formatter func(interface{}) string
 
-type specification interface {
-	isSatisfiedBy(a, b interface{}) bool
-	areEqual(a, b interface{}) bool
-}
-
-// deepEquality compares any two values using reflect.DeepEqual.
+// deepEquality compares any two values of the same type much like
+// reflect.DeepEqual, walking pointers, structs, maps, slices, and arrays
+// (while tolerating cycles) but applying the other specifications to the
+// values found at every level. So, for instance, time.Time values nested
+// in a struct are compared using their Equal method.
 // https://golang.org/pkg/reflect/#DeepEqual
 type deepEquality struct{}
 
-func (this deepEquality) isSatisfiedBy(a, b interface{}) bool {
+func (this deepEquality) IsSatisfiedBy(a, b interface{}) bool {
 	return reflect.TypeOf(a) == reflect.TypeOf(b)
 }
-func (this deepEquality) areEqual(a, b interface{}) bool {
-	return reflect.DeepEqual(a, b)
+func (this deepEquality) AreEqual(a, b interface{}) bool {
+	return equivalent(a, b, nil)
 }
 
 // numericEquality compares numeric values using the built-in equality
@@ -137,10 +170,13 @@ func (this deepEquality) areEqual(a, b interface{}) bool {
 // directions. https://golang.org/pkg/reflect/#Kind
 type numericEquality struct{}
 
-func (this numericEquality) isSatisfiedBy(a, b interface{}) bool {
+func (this numericEquality) IsSatisfiedBy(a, b interface{}) bool {
 	return isNumeric(a) && isNumeric(b)
 }
-func (this numericEquality) areEqual(a, b interface{}) bool {
+func (this numericEquality) AreEqual(a, b interface{}) bool {
+	if reflect.TypeOf(a) == reflect.TypeOf(b) {
+		return a == b
+	}
 	aValue := reflect.ValueOf(a)
 	bValue := reflect.ValueOf(b)
 	aAsB := aValue.Convert(bValue.Type()).Interface()
@@ -148,6 +184,9 @@ func (this numericEquality) areEqual(a, b interface{}) bool {
 	return a == bAsA && b == aAsB
 }
 func isNumeric(v interface{}) bool {
+	if v == nil {
+		return false
+	}
 	_, found := numericKinds[reflect.TypeOf(v).Kind()]
 	return found
 }
@@ -156,10 +195,10 @@ func isNumeric(v interface{}) bool {
 // https://golang.org/pkg/time/#Time.Equal
 type timeEquality struct{}
 
-func (this timeEquality) isSatisfiedBy(a, b interface{}) bool {
+func (this timeEquality) IsSatisfiedBy(a, b interface{}) bool {
 	return isTime(a) && isTime(b)
 }
-func (this timeEquality) areEqual(a, b interface{}) bool {
+func (this timeEquality) AreEqual(a, b interface{}) bool {
 	return a.(time.Time).Equal(b.(time.Time))
 }
 func isTime(v interface{}) bool {
@@ -167,17 +206,25 @@ func isTime(v interface{}) bool {
 	return ok
 }
 
-var numericKinds = map[reflect.Kind]struct{}{
-	reflect.Int:     {},
-	reflect.Int8:    {},
-	reflect.Int16:   {},
-	reflect.Int32:   {},
-	reflect.Int64:   {},
-	reflect.Uint:    {},
-	reflect.Uint8:   {},
-	reflect.Uint16:  {},
-	reflect.Uint32:  {},
-	reflect.Uint64:  {},
-	reflect.Float32: {},
-	reflect.Float64: {},
+var numericKinds = func() map[reflect.Kind]struct{} {
+	kinds := make(map[reflect.Kind]struct{})
+	for _, kind := range numericKindList {
+		kinds[kind] = struct{}{}
+	}
+	return kinds
+}()
+
+var numericKindList = []reflect.Kind{
+	reflect.Int,
+	reflect.Int8,
+	reflect.Int16,
+	reflect.Int32,
+	reflect.Int64,
+	reflect.Uint,
+	reflect.Uint8,
+	reflect.Uint16,
+	reflect.Uint32,
+	reflect.Uint64,
+	reflect.Float32,
+	reflect.Float64,
 }
@@ -0,0 +1,259 @@
+package should
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+)
+
+// EqualJSON verifies that actual and expected[0] represent equivalent JSON
+// documents. Each may be provided as a string, []byte, or json.RawMessage
+// (which are parsed as JSON) or as any other value (which is first passed
+// to json.Marshal). Objects are compared without regard to key order or
+// whitespace, and numbers are compared by value (so 1, 1.0, and 1e0 are
+// all equivalent).
+func EqualJSON(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	a, b, err := parseJSONPair(actual, expected[0])
+	if err != nil {
+		return err
+	}
+
+	differ := new(jsonDiffer)
+	differ.diff("$", a, b)
+	if len(differ.differences) == 0 {
+		return nil
+	}
+
+	return failure("%s", jsonReport(a, b, differ.differences))
+}
+
+// EqualJSON (negated!)
+func (negated) EqualJSON(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = EqualJSON(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("\n"+
+		"  expected JSON:      %s\n"+
+		"  to not be equal to: %s\n"+
+		"  (but it was)",
+		compactJSON(expected[0]),
+		compactJSON(actual),
+	)
+}
+
+// MatchJSONSubset verifies that the JSON document represented by actual
+// contains the JSON document represented by expected[0] (see EqualJSON for
+// the accepted representations). Every member of each expected object must
+// be present (and match) in the corresponding actual object, which may also
+// have additional members. Arrays must be of equal length, with each actual
+// element matching the expected element at the same position.
+func MatchJSONSubset(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	a, b, err := parseJSONPair(actual, expected[0])
+	if err != nil {
+		return err
+	}
+
+	differ := &jsonDiffer{subset: true}
+	differ.diff("$", a, b)
+	if len(differ.differences) == 0 {
+		return nil
+	}
+
+	return failure("%s", jsonReport(a, b, differ.differences))
+}
+
+func parseJSONPair(actual, expected interface{}) (a, b interface{}, err error) {
+	a, err = parseJSON(actual)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w (actual)", err)
+	}
+	b, err = parseJSON(expected)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w (expected)", err)
+	}
+	return a, b, nil
+}
+
+func parseJSON(v interface{}) (interface{}, error) {
+	var raw []byte
+	switch value := v.(type) {
+	case string:
+		raw = []byte(value)
+	case []byte:
+		raw = value
+	case json.RawMessage:
+		raw = value
+	default:
+		marshaled, err := json.Marshal(v)
+		if err != nil {
+			return nil, wrap(ErrInvalidJSON, "%s", err)
+		}
+		raw = marshaled
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var parsed interface{}
+	err := decoder.Decode(&parsed)
+	if err != nil {
+		return nil, wrap(ErrInvalidJSON, "%s", err)
+	}
+	if decoder.Decode(new(interface{})) != io.EOF {
+		return nil, wrap(ErrInvalidJSON, "unexpected content after top-level value")
+	}
+	return parsed, nil
+}
+
+// jsonDiffer walks two parsed JSON documents in parallel
+// and collects the path of each difference it encounters.
+type jsonDiffer struct {
+	subset      bool
+	differences []difference
+}
+
+func (this *jsonDiffer) record(path string, actual, expected string) {
+	this.differences = append(this.differences, difference{path: path, actual: actual, expected: expected})
+}
+
+func (this *jsonDiffer) diff(path string, actual, expected interface{}) {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			this.record(path, marshalJSON(actual), marshalJSON(expected))
+			return
+		}
+		for _, key := range jsonKeys(a, e, this.subset) {
+			keyPath := jsonPath(path, key)
+			actualValue, inActual := a[key]
+			expectedValue, inExpected := e[key]
+			switch {
+			case !inActual:
+				this.record(keyPath, absent, marshalJSON(expectedValue))
+			case !inExpected:
+				this.record(keyPath, marshalJSON(actualValue), absent)
+			default:
+				this.diff(keyPath, actualValue, expectedValue)
+			}
+		}
+
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok {
+			this.record(path, marshalJSON(actual), marshalJSON(expected))
+			return
+		}
+		for x := 0; x < len(a) || x < len(e); x++ {
+			indexPath := fmt.Sprintf("%s[%d]", path, x)
+			switch {
+			case x >= len(a):
+				this.record(indexPath, absent, marshalJSON(e[x]))
+			case x >= len(e):
+				this.record(indexPath, marshalJSON(a[x]), absent)
+			default:
+				this.diff(indexPath, a[x], e[x])
+			}
+		}
+
+	case json.Number:
+		a, ok := actual.(json.Number)
+		if !ok || !jsonNumbersEqual(a, e) {
+			this.record(path, marshalJSON(actual), marshalJSON(expected))
+		}
+
+	default: // string, bool, nil
+		if actual != expected {
+			this.record(path, marshalJSON(actual), marshalJSON(expected))
+		}
+	}
+}
+
+// jsonKeys lists (in sorted order) the keys of the expected object
+// and, unless only a subset is required, those of the actual object.
+func jsonKeys(actual, expected map[string]interface{}, subset bool) []string {
+	var keys []string
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	if !subset {
+		for key := range actual {
+			if _, found := expected[key]; !found {
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func jsonNumbersEqual(a, b json.Number) bool {
+	x, _, errA := big.ParseFloat(a.String(), 10, 256, big.ToNearestEven)
+	y, _, errB := big.ParseFloat(b.String(), 10, 256, big.ToNearestEven)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return x.Cmp(y) == 0
+}
+
+func jsonPath(parent, key string) string {
+	if jsonIdentifier.MatchString(key) {
+		return parent + "." + key
+	}
+	return fmt.Sprintf("%s[%q]", parent, key)
+}
+
+var jsonIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func jsonReport(actual, expected interface{}, differences []difference) string {
+	return fmt.Sprintf("\n"+
+		"Expected JSON: %s\n"+
+		"Actual JSON:   %s\n"+
+		"Differences (actual != expected):\n%s",
+		marshalJSON(expected),
+		marshalJSON(actual),
+		formatDifferences(differences),
+	)
+}
+
+// compactJSON renders v (a JSON document, see EqualJSON) on a single line.
+func compactJSON(v interface{}) string {
+	parsed, err := parseJSON(v)
+	if err != nil {
+		return Format(v)
+	}
+	return marshalJSON(parsed)
+}
+
+// marshalJSON renders v (an already parsed JSON value) on a single
+// line. Unlike compactJSON, a string value is never read as a document.
+func marshalJSON(v interface{}) string {
+	marshaled, err := json.Marshal(v)
+	if err != nil {
+		return Format(v)
+	}
+	return string(marshaled)
+}
@@ -0,0 +1,84 @@
+package should_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestShouldEqualJSON(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(`{}`, should.EqualJSON)
+	assert.ExpectedCountInvalid(`{}`, should.EqualJSON, `{}`, "EXTRA")
+
+	assert.InvalidJSON(`{`, should.EqualJSON, `{}`)
+	assert.InvalidJSON(`{}`, should.EqualJSON, `{} {}`)
+	assert.InvalidJSON(`{}}`, should.EqualJSON, `{}`)
+	assert.InvalidJSON(`[]`, should.EqualJSON, `[] ]`)
+	assert.InvalidJSON(`1`, should.EqualJSON, `1 x`)
+	assert.InvalidJSON(func() {}, should.EqualJSON, `{}`)
+
+	assert.Pass(`{"a": 1, "b": [true, null]}`, should.EqualJSON, `{"b":[true,null],"a":1}`)
+	assert.Pass([]byte(`{"a": 1.0}`), should.EqualJSON, json.RawMessage(`{"a":1e0}`))
+	assert.Pass(`{"A":"x","B":2}`, should.EqualJSON, struct{ A, B interface{} }{"x", 2})
+	assert.Pass(`12345678901234567890`, should.EqualJSON, `1.2345678901234567890e19`)
+
+	assert.Fail(`{"a": 1}`, should.EqualJSON, `{"a": 2}`)
+	assert.Fail(`{"a": 1}`, should.EqualJSON, `{"a": "1"}`)
+	assert.Fail(`{"a": 1}`, should.EqualJSON, `{"a": 1, "b": 2}`)
+	assert.Fail(`[1, 2]`, should.EqualJSON, `[2, 1]`)
+	assert.Fail(`12345678901234567890`, should.EqualJSON, `12345678901234567891`)
+
+	assert.Fail(`{"a": 1}`, should.NOT.EqualJSON, `{ "a" : 1.0 }`)
+	assert.Pass(`{"a": 1}`, should.NOT.EqualJSON, `{"a": 2}`)
+}
+
+func TestShouldEqualJSONReportsDifferencesByPath(t *testing.T) {
+	err := should.EqualJSON(
+		`{"orders": [{"qty": 1}, {"qty": 2, "x-id": "a"}], "extra": true}`,
+		`{"orders": [{"qty": 1}, {"qty": 3, "x-id": "a"}, {}]}`,
+	)
+
+	assertReportContains(t, err,
+		"  $.extra: true != <absent>\n",
+		"  $.orders[1].qty: 2 != 3\n",
+		"  $.orders[2]: <absent> != {}",
+	)
+}
+
+func TestShouldEqualJSONReportsStringLeavesAsStrings(t *testing.T) {
+	err := should.EqualJSON(`{"a": 1, "b": "[1,2]"}`, `{"a": "1", "b": [1, 2]}`)
+
+	assertReportContains(t, err,
+		"  $.a: 1 != \"1\"\n",
+		"  $.b: \"[1,2]\" != [1,2]",
+	)
+}
+
+func TestShouldEqualJSONReportsPercentSignsVerbatim(t *testing.T) {
+	for _, err := range []error{
+		should.EqualJSON(`{"a": "100%d"}`, `{"a": "5%s"}`),
+		should.MatchJSONSubset(`{"a": "100%d"}`, `{"a": "5%s"}`),
+	} {
+		assertReportContains(t, err, `$.a: "100%d" != "5%s"`)
+		if strings.Contains(err.Error(), "%!") {
+			t.Error("the report should not be treated as a format string:", err)
+		}
+	}
+}
+
+func TestShouldMatchJSONSubset(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(`{}`, should.MatchJSONSubset)
+	assert.InvalidJSON(`{}`, should.MatchJSONSubset, `[`)
+
+	assert.Pass(`{"a": 1, "b": {"c": 2, "d": 3}}`, should.MatchJSONSubset, `{"b": {"c": 2.0}}`)
+	assert.Pass(`[{"a": 1, "b": 2}]`, should.MatchJSONSubset, `[{"a": 1}]`)
+	assert.Fail(`{"a": 1}`, should.MatchJSONSubset, `{"a": 1, "b": 2}`)
+	assert.Fail(`{"b": {"c": 2}}`, should.MatchJSONSubset, `{"b": {"c": 3}}`)
+	assert.Fail(`[{"a": 1}, {"a": 2}]`, should.MatchJSONSubset, `[{"a": 1}]`)
+}
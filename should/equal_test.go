@@ -1,6 +1,7 @@
 package should_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -37,3 +38,83 @@ func TestShouldNotEqual(t *testing.T) {
 	assert.Fail(1, should.NOT.Equal, 1)
 	assert.Pass(1, should.NOT.Equal, 2)
 }
+
+func TestShouldEqualAppliesSpecificationsWithinCompositeValues(t *testing.T) {
+	assert := NewAssertion(t)
+
+	type Event struct {
+		At    time.Time
+		Count interface{}
+	}
+	now := time.Now()
+
+	assert.Pass(Event{At: now}, should.Equal, Event{At: now.UTC()})
+	assert.Pass([]Event{{At: now}}, should.Equal, []Event{{At: now.In(time.Local)}})
+	assert.Pass(map[string]*Event{"a": {At: now}}, should.Equal, map[string]*Event{"a": {At: now.UTC()}})
+	assert.Pass([1]interface{}{now}, should.Equal, [1]interface{}{now.UTC()})
+	assert.Fail(Event{At: now}, should.Equal, Event{At: now.Add(1)})
+
+	assert.Pass(Event{Count: 1}, should.Equal, Event{Count: uint8(1)})
+	assert.Pass([]interface{}{1, 2.0}, should.Equal, []interface{}{int64(1), float32(2)})
+	assert.Fail(Event{Count: 1}, should.Equal, Event{Count: 2})
+	assert.Fail(Event{Count: 1}, should.Equal, Event{Count: "1"})
+}
+
+func TestShouldEqualHandlesCycles(t *testing.T) {
+	assert := NewAssertion(t)
+
+	type Node struct {
+		At   time.Time
+		Next *Node
+	}
+	now := time.Now()
+	a := &Node{At: now}
+	a.Next = a
+	b := &Node{At: now.UTC()}
+	b.Next = b
+	c := &Node{At: now.Add(1)}
+	c.Next = c
+
+	assert.Pass(a, should.Equal, b)
+	assert.Fail(a, should.Equal, c)
+}
+
+func TestShouldEqualMatchesMapKeysByIdentity(t *testing.T) {
+	assert := NewAssertion(t)
+
+	a, b, c := 1, 2, 2
+	assert.Pass(map[*int]string{&a: "x", &b: "y"}, should.Equal, map[*int]string{&a: "x", &b: "y"})
+	assert.Fail(map[*int]string{&a: "x", &b: "y"}, should.Equal, map[*int]string{&a: "x", &c: "y"})
+	assert.Fail(map[*int]string{&a: "x", &b: "y"}, should.Equal, map[*int]string{&a: "x", &c: "z"})
+
+	var short, long [40]int // (which are formatted alike, being longer than the printer shows)
+	long[39] = 1
+	assert.Pass(map[[40]int]int{short: 1, long: 2}, should.Equal, map[[40]int]int{short: 1, long: 2})
+	assert.Fail(map[[40]int]int{short: 1}, should.Equal, map[[40]int]int{long: 1})
+
+	err := should.Equal(map[*int]string{&a: "x", &b: "y"}, map[*int]string{&a: "x", &c: "z"})
+	assertReportContains(t, err,
+		`[&2]: "y" != <absent>`,
+		`[&2]: <absent> != "z"`,
+	)
+}
+
+func TestShouldEqualComparesTimesWithinUnexportedFields(t *testing.T) {
+	assert := NewAssertion(t)
+
+	type stamped struct{ at time.Time }
+	now := time.Now()
+
+	assert.Pass(stamped{at: now}, should.Equal, stamped{at: now.UTC()})
+	assert.Pass(&stamped{at: now}, should.Equal, &stamped{at: now.In(time.Local)})
+	assert.Pass([]stamped{{at: now}}, should.Equal, []stamped{{at: now.UTC()}})
+	assert.Pass(map[int]stamped{1: {at: now}}, should.Equal, map[int]stamped{1: {at: now.UTC()}})
+	assert.Pass([]interface{}{stamped{at: now}}, should.Equal, []interface{}{stamped{at: now.UTC()}})
+	assert.Fail(stamped{at: now}, should.Equal, stamped{at: now.Add(1)})
+
+	err := should.Equal(stamped{at: now}, stamped{at: now.Add(1)})
+	assertReportContains(t, err, ".at: time.Time(")
+	if strings.Contains(err.Error(), "wall") || strings.Contains(err.Error(), ".at.") {
+		t.Error("times should be compared (and reported) as instants:", err)
+	}
+}
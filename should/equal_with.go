@@ -0,0 +1,196 @@
+package should
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// EqualWith returns an assertion which verifies that the actual value is
+// equal to the expected value, as configured by the provided options:
+//
+//	So(actual, should.EqualWith(should.IgnoreFields("ID"), should.EquateEmpty()), expected)
+//
+// Values are compared as with Equal (applying the same specifications at
+// every level of composite values), except as modified by the options,
+// which take precedence. The failure report honors the same options.
+func EqualWith(options ...EqualOption) func(actual interface{}, expected ...interface{}) error {
+	config := new(equalityOptions)
+	for _, option := range options {
+		option(config)
+	}
+
+	return func(actual interface{}, expected ...interface{}) (err error) {
+		defer annotate(&err, actual, expected)
+		err = validateExpected(1, expected)
+		if err != nil {
+			return err
+		}
+
+		if config.err != nil {
+			return config.err
+		}
+
+		if equivalent(actual, expected[0], config) {
+			return nil
+		}
+
+		return diffFailure(equalityDiff(actual, expected[0], config), "%s", report(actual, expected[0], config))
+	}
+}
+
+// EqualWith (negated!)
+func (negated) EqualWith(options ...EqualOption) func(actual interface{}, expected ...interface{}) error {
+	equal := EqualWith(options...)
+	return func(actual interface{}, expected ...interface{}) (err error) {
+		defer annotate(&err, actual, expected)
+		err = equal(actual, expected...)
+		if errors.Is(err, ErrAssertionFailure) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return failure("\n"+
+			"  expected:     %s\n"+
+			"  to not equal: %s\n"+
+			"  (but it did, given the provided options)",
+			Format(expected[0]),
+			Format(actual),
+		)
+	}
+}
+
+// EqualOption configures the comparison performed by EqualWith.
+type EqualOption func(*equalityOptions)
+
+// IgnoreFields causes EqualWith to skip the struct fields at the provided
+// paths. A path names the fields traversed from the root value, separated
+// by dots (ie. "Orders.CreatedAt"), and excludes any slice indexes or map
+// keys along the way (so the path above refers to the CreatedAt field of
+// every element of the Orders slice).
+func IgnoreFields(paths ...string) EqualOption {
+	return func(this *equalityOptions) {
+		if this.ignoredFields == nil {
+			this.ignoredFields = make(map[string]bool)
+		}
+		for _, path := range paths {
+			this.ignoredFields[strings.TrimPrefix(path, ".")] = true
+		}
+	}
+}
+
+// IgnoreUnexported causes EqualWith to skip all unexported struct fields.
+func IgnoreUnexported() EqualOption {
+	return func(this *equalityOptions) {
+		this.ignoreUnexported = true
+	}
+}
+
+// EquateEmpty causes EqualWith to consider nil and empty
+// slices (and nil and empty maps) to be equal.
+func EquateEmpty() EqualOption {
+	return func(this *equalityOptions) {
+		this.equateEmpty = true
+	}
+}
+
+// SortSlices causes EqualWith to sort (copies of) slices before comparing
+// them element by element. The less function must be of the form
+// func(a, b T) bool, and applies to slices whose elements are of type T.
+func SortSlices(less interface{}) EqualOption {
+	return func(this *equalityOptions) {
+		elem, err := validateBinaryFunc(less)
+		if err != nil {
+			this.err = fmt.Errorf("SortSlices: %w", err)
+			return
+		}
+		if this.sorters == nil {
+			this.sorters = make(map[reflect.Type]reflect.Value)
+		}
+		this.sorters[elem] = reflect.ValueOf(less)
+	}
+}
+
+// Comparer causes EqualWith to compare all values of type T using the
+// provided equal function, which must be of the form func(a, b T) bool.
+func Comparer(equal interface{}) EqualOption {
+	return func(this *equalityOptions) {
+		typ, err := validateBinaryFunc(equal)
+		if err != nil {
+			this.err = fmt.Errorf("Comparer: %w", err)
+			return
+		}
+		if this.comparers == nil {
+			this.comparers = make(map[reflect.Type]reflect.Value)
+		}
+		this.comparers[typ] = reflect.ValueOf(equal)
+	}
+}
+
+// FloatTolerance causes EqualWith to consider floating point values
+// (of the same type) to be equal when they are within the tolerance
+// (see WithinDelta, WithinEpsilon, and WithinULPs).
+func FloatTolerance(tolerance Tolerance) EqualOption {
+	return func(this *equalityOptions) {
+		this.floatTolerance = &tolerance
+	}
+}
+
+type equalityOptions struct {
+	err              error
+	ignoredFields    map[string]bool
+	ignoreUnexported bool
+	equateEmpty      bool
+	sorters          map[reflect.Type]reflect.Value
+	comparers        map[reflect.Type]reflect.Value
+	floatTolerance   *Tolerance
+}
+
+// ignores reports whether the field (found at the provided path) is to be skipped.
+func (this *equalityOptions) ignores(field reflect.StructField, path string) bool {
+	return this.ignoredFields[path] || (this.ignoreUnexported && field.PkgPath != "")
+}
+
+// compare applies any custom comparison that pertains to the values,
+// reporting the outcome and whether any such comparison was applied.
+func (this *equalityOptions) compare(actual, expected reflect.Value) (equal, ok bool) {
+	if comparer, found := this.comparers[actual.Type()]; found && actual.CanInterface() {
+		return comparer.Call([]reflect.Value{actual, expected})[0].Bool(), true
+	}
+	if this.floatTolerance != nil && isFloatKind(actual.Kind()) {
+		_, within := this.floatTolerance.compare(actual, expected)
+		return within, true
+	}
+	return false, false
+}
+
+// sort returns a sorted copy of the slice, if a sorter pertains to its elements.
+func (this *equalityOptions) sort(slice reflect.Value) reflect.Value {
+	less, found := this.sorters[slice.Type().Elem()]
+	if !found || !slice.CanInterface() {
+		return slice
+	}
+	sorted := reflect.MakeSlice(slice.Type(), slice.Len(), slice.Len())
+	reflect.Copy(sorted, slice)
+	sort.SliceStable(sorted.Interface(), func(i, j int) bool {
+		return less.Call([]reflect.Value{sorted.Index(i), sorted.Index(j)})[0].Bool()
+	})
+	return sorted
+}
+
+// validateBinaryFunc ensures that f is of the form func(a, b T) bool,
+// returning T.
+func validateBinaryFunc(f interface{}) (reflect.Type, error) {
+	typ := reflect.TypeOf(f)
+	if typ == nil || typ.Kind() != reflect.Func ||
+		typ.NumIn() != 2 || typ.In(0) != typ.In(1) ||
+		typ.NumOut() != 1 || typ.Out(0).Kind() != reflect.Bool {
+		return nil, wrap(ErrTypeMismatch, "got %v, want func(a, b T) bool", typ)
+	}
+	return typ.In(0), nil
+}
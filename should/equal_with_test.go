@@ -0,0 +1,92 @@
+package should_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+type Record struct {
+	ID      int
+	Name    string
+	Tags    []string
+	Meta    map[string]string
+	Created time.Time
+	Score   float64
+	Lines   []Line
+	private string
+}
+
+type Line struct {
+	ID  int
+	SKU string
+}
+
+func TestShouldEqualWith(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1, should.EqualWith())
+	assert.ExpectedCountInvalid(1, should.EqualWith(), 1, "EXTRA")
+	assert.TypeMismatch(1, should.EqualWith(should.Comparer(42)), 1)
+	assert.TypeMismatch(1, should.EqualWith(should.SortSlices(func(a int, b string) bool { return false })), 1)
+
+	assert.Pass(Record{Name: "a"}, should.EqualWith(), Record{Name: "a"})
+	assert.Fail(Record{Name: "a"}, should.EqualWith(), Record{Name: "b"})
+	assert.Pass(1, should.EqualWith(), int64(1))
+	assert.Fail(1, should.EqualWith(), "1")
+
+	// IgnoreFields:
+	ignoreIDs := should.EqualWith(should.IgnoreFields("ID", "Lines.ID", ".Created"))
+	assert.Pass(
+		Record{ID: 1, Created: time.Now(), Lines: []Line{{ID: 1, SKU: "a"}}}, ignoreIDs,
+		Record{ID: 2, Created: time.Now().Add(time.Hour), Lines: []Line{{ID: 2, SKU: "a"}}},
+	)
+	assert.Fail(Record{Lines: []Line{{ID: 1, SKU: "a"}}}, ignoreIDs, Record{Lines: []Line{{ID: 2, SKU: "b"}}})
+	assert.Pass(&Record{ID: 1}, ignoreIDs, &Record{ID: 2})
+
+	// IgnoreUnexported:
+	assert.Fail(Record{private: "a"}, should.EqualWith(), Record{private: "b"})
+	assert.Pass(Record{private: "a"}, should.EqualWith(should.IgnoreUnexported()), Record{private: "b"})
+
+	// EquateEmpty:
+	assert.Fail(Record{Tags: []string{}}, should.EqualWith(), Record{})
+	assert.Pass(Record{Tags: []string{}, Meta: map[string]string{}}, should.EqualWith(should.EquateEmpty()), Record{})
+	assert.Fail(Record{Tags: []string{""}}, should.EqualWith(should.EquateEmpty()), Record{})
+
+	// SortSlices:
+	sortStrings := should.EqualWith(should.SortSlices(func(a, b string) bool { return a < b }))
+	assert.Pass(Record{Tags: []string{"b", "a"}}, sortStrings, Record{Tags: []string{"a", "b"}})
+	assert.Fail(Record{Tags: []string{"b", "a"}}, sortStrings, Record{Tags: []string{"a", "c"}})
+	assert.Fail([]int{2, 1}, sortStrings, []int{1, 2})
+
+	// Comparer:
+	caseless := should.EqualWith(should.Comparer(func(a, b string) bool { return strings.EqualFold(a, b) }))
+	assert.Pass(Record{Name: "BOB", Tags: []string{"X"}}, caseless, Record{Name: "bob", Tags: []string{"x"}})
+	instants := should.EqualWith(should.Comparer(func(a, b time.Time) bool { return a.Equal(b) }))
+	now := time.Now()
+	assert.Pass(Record{Created: now}, instants, Record{Created: now.UTC()})
+
+	// FloatTolerance:
+	approximately := should.EqualWith(should.FloatTolerance(should.WithinDelta(0.01)))
+	assert.Pass(Record{Score: 1.001}, approximately, Record{Score: 1.0})
+	assert.Fail(Record{Score: 1.1}, approximately, Record{Score: 1.0})
+}
+
+func TestShouldNotEqualWith(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1, should.NOT.EqualWith())
+	assert.Fail(Record{ID: 1}, should.NOT.EqualWith(should.IgnoreFields("ID")), Record{ID: 2})
+	assert.Pass(Record{ID: 1}, should.NOT.EqualWith(), Record{ID: 2})
+}
+
+func TestShouldEqualWithReportHonorsOptions(t *testing.T) {
+	err := should.EqualWith(should.IgnoreFields("ID"))(Record{ID: 1, Name: "a"}, Record{ID: 2, Name: "b"})
+
+	assertReportContains(t, err, `.Name: "a" != "b"`)
+	if strings.Contains(err.Error(), ".ID") {
+		t.Error("report should not mention ignored fields:", err)
+	}
+}
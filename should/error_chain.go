@@ -0,0 +1,41 @@
+package should
+
+import (
+	"fmt"
+	"strings"
+)
+
+// errorChain renders err along with every error it wraps, one per line,
+// each indented beneath the error that wraps it. Errors that wrap several
+// others (via an `Unwrap() []error` method, as produced by errors.Join
+// or fmt.Errorf with multiple %w verbs) have each branch rendered in turn.
+func errorChain(err error) string {
+	builder := new(strings.Builder)
+	writeErrorChain(builder, err, 0)
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+func writeErrorChain(builder *strings.Builder, err error, depth int) {
+	if depth > maxErrorChainDepth {
+		_, _ = fmt.Fprintf(builder, "\t%s...\n", strings.Repeat("  ", depth))
+		return
+	}
+	_, _ = fmt.Fprintf(builder, "\t%s(%T) %s\n", strings.Repeat("  ", depth), err, err)
+	for _, inner := range unwrapAll(err) {
+		writeErrorChain(builder, inner, depth+1)
+	}
+}
+
+func unwrapAll(err error) []error {
+	switch wrapper := err.(type) {
+	case interface{ Unwrap() error }:
+		if inner := wrapper.Unwrap(); inner != nil {
+			return []error{inner}
+		}
+	case interface{ Unwrap() []error }:
+		return wrapper.Unwrap()
+	}
+	return nil
+}
+
+// maxErrorChainDepth guards against (pathological) errors that wrap themselves.
+const maxErrorChainDepth = 32
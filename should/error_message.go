@@ -0,0 +1,172 @@
+package should
+
+import (
+	"errors"
+	"strings"
+)
+
+// HaveErrorMessage verifies that actual is an error value whose
+// Error() method returns exactly the string provided as expected[0].
+func HaveErrorMessage(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	outer, ok := actual.(error)
+	if !ok {
+		return errTypeMismatch(actual)
+	}
+
+	err = validateType(expected[0], "")
+	if err != nil {
+		return err
+	}
+
+	if outer.Error() == expected[0] {
+		return nil
+	}
+
+	return failure("\n"+
+		"\tgot message:  %q\n"+
+		"\twant message: %q\n"+
+		"\terror chain:\n%s",
+		outer.Error(),
+		expected[0],
+		errorChain(outer),
+	)
+}
+
+// HaveErrorMessage (negated!)
+func (negated) HaveErrorMessage(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = HaveErrorMessage(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("\n"+
+		"\tgot message:             %q\n"+
+		"\twant any message except: %q\n"+
+		"\terror chain:\n%s",
+		actual.(error).Error(),
+		expected[0],
+		errorChain(actual.(error)),
+	)
+}
+
+// ContainErrorMessage verifies that actual is an error value whose
+// Error() method returns a string containing expected[0] (a string).
+func ContainErrorMessage(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	outer, ok := actual.(error)
+	if !ok {
+		return errTypeMismatch(actual)
+	}
+
+	err = validateType(expected[0], "")
+	if err != nil {
+		return err
+	}
+
+	if strings.Contains(outer.Error(), expected[0].(string)) {
+		return nil
+	}
+
+	return failure("\n"+
+		"\tgot message:       %q\n"+
+		"\twant message with: %q\n"+
+		"\terror chain:\n%s",
+		outer.Error(),
+		expected[0],
+		errorChain(outer),
+	)
+}
+
+// ContainErrorMessage (negated!)
+func (negated) ContainErrorMessage(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = ContainErrorMessage(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("\n"+
+		"\tgot message:          %q\n"+
+		"\twant message without: %q\n"+
+		"\terror chain:\n%s",
+		actual.(error).Error(),
+		expected[0],
+		errorChain(actual.(error)),
+	)
+}
+
+// MatchErrorMessage verifies that actual is an error value whose Error()
+// method returns a string matched by the regular expression provided as
+// expected[0] (either a pattern string or a *regexp.Regexp).
+func MatchErrorMessage(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	outer, ok := actual.(error)
+	if !ok {
+		return errTypeMismatch(actual)
+	}
+
+	pattern, err := compilePattern(expected[0])
+	if err != nil {
+		return err
+	}
+
+	if pattern.MatchString(outer.Error()) {
+		return nil
+	}
+
+	return failure("\n"+
+		"\tgot message:        %q\n"+
+		"\twant message match: %s\n"+
+		"\terror chain:\n%s",
+		outer.Error(),
+		pattern,
+		errorChain(outer),
+	)
+}
+
+// MatchErrorMessage (negated!)
+func (negated) MatchErrorMessage(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = MatchErrorMessage(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("\n"+
+		"\tgot message:       %q\n"+
+		"\twant no match for: %s\n"+
+		"\terror chain:\n%s",
+		actual.(error).Error(),
+		expected[0],
+		errorChain(actual.(error)),
+	)
+}
@@ -0,0 +1,69 @@
+package should_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestShouldHaveErrorMessage(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(outer, should.HaveErrorMessage)
+	assert.ExpectedCountInvalid(outer, should.HaveErrorMessage, "outer(inner)", "EXTRA")
+	assert.TypeMismatch("outer(inner)", should.HaveErrorMessage, "outer(inner)")
+	assert.TypeMismatch(outer, should.HaveErrorMessage, 42)
+
+	assert.Pass(outer, should.HaveErrorMessage, "outer(inner)")
+	assert.Fail(outer, should.HaveErrorMessage, "outer")
+
+	assert.Fail(outer, should.NOT.HaveErrorMessage, "outer(inner)")
+	assert.Pass(outer, should.NOT.HaveErrorMessage, "outer")
+}
+
+func TestShouldContainErrorMessage(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(outer, should.ContainErrorMessage)
+	assert.TypeMismatch("outer(inner)", should.ContainErrorMessage, "inner")
+	assert.TypeMismatch(outer, should.ContainErrorMessage, 42)
+
+	assert.Pass(outer, should.ContainErrorMessage, "inner")
+	assert.Fail(outer, should.ContainErrorMessage, "nope")
+
+	assert.Fail(outer, should.NOT.ContainErrorMessage, "inner")
+	assert.Pass(outer, should.NOT.ContainErrorMessage, "nope")
+}
+
+func TestShouldMatchErrorMessage(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(outer, should.MatchErrorMessage)
+	assert.TypeMismatch("outer(inner)", should.MatchErrorMessage, "inner")
+	assert.TypeMismatch(outer, should.MatchErrorMessage, 42)
+	assert.InvalidPattern(outer, should.MatchErrorMessage, "(")
+
+	assert.Pass(outer, should.MatchErrorMessage, `^outer\(\w+\)$`)
+	assert.Pass(outer, should.MatchErrorMessage, regexp.MustCompile(`inn?er`))
+	assert.Fail(outer, should.MatchErrorMessage, `^inner`)
+
+	assert.Fail(outer, should.NOT.MatchErrorMessage, "inner")
+	assert.Pass(outer, should.NOT.MatchErrorMessage, "^inner")
+}
+
+func TestNegatedErrorMessageFailuresRenderErrorChain(t *testing.T) {
+	chain := "error chain:\n" +
+		"\t(*fmt.wrapError) outer(inner)\n" +
+		"\t  (*errors.errorString) inner"
+
+	assertReportContains(t, should.NOT.HaveErrorMessage(outer, "outer(inner)"),
+		`got message:             "outer(inner)"`,
+		chain,
+	)
+	assertReportContains(t, should.NOT.MatchErrorMessage(outer, "inner"),
+		`got message:       "outer(inner)"`,
+		"want no match for: inner",
+		chain,
+	)
+}
@@ -10,11 +10,12 @@ var (
 	ErrTypeMismatch         = errors.New("type mismatch")
 	ErrKindMismatch         = errors.New("kind mismatch")
 	ErrAssertionFailure     = errors.New("assertion failure")
+	ErrInvalidPattern       = errors.New("invalid pattern")
+	ErrInvalidJSON          = errors.New("invalid JSON")
+	ErrInvalidFieldPath     = errors.New("invalid field path")
+	ErrInvalidArgument      = errors.New("invalid argument")
 )
 
-func failure(format string, args ...interface{}) error {
-	return wrap(ErrAssertionFailure, format, args...)
-}
 func wrap(inner error, format string, args ...interface{}) error {
 	return fmt.Errorf("%w: "+fmt.Sprintf(format, args...), inner)
 }
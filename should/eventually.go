@@ -0,0 +1,162 @@
+package should
+
+import (
+	"errors"
+	"reflect"
+	"time"
+)
+
+// Eventually repeatedly invokes the func provided as actual (which takes
+// no arguments and returns a single value, like func() interface{}) and
+// applies the assertion provided as expected[2] (along with any further
+// expected values) to the value it returns, until that assertion passes.
+// Attempts are made every interval (expected[1]) until the timeout
+// (expected[0]) expires (with a final attempt made at that moment), at
+// which point the last failure is reported along with the number of
+// attempts made and the time elapsed:
+//
+//	So(func() interface{} { return len(queue) }, should.Eventually, time.Second, time.Millisecond*10, should.Equal, 0)
+func Eventually(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	poll, err := newPoller(actual, expected)
+	if err != nil {
+		return err
+	}
+
+	for {
+		err = poll.attempt()
+		if !errors.Is(err, ErrAssertionFailure) {
+			return err
+		}
+		if !poll.wait() {
+			break
+		}
+	}
+
+	return failure("\n"+
+		"  gave up after: %v (%d attempt%s)\n"+
+		"%s",
+		poll.elapsed(),
+		poll.attempts,
+		pluralize(poll.attempts),
+		describeFailure("last failure", err),
+	)
+}
+
+// Consistently is like Eventually, but requires that the assertion passes
+// on every attempt made until the timeout (expected[0]) expires. The first
+// failure is reported along with the number of attempts made and the time
+// elapsed.
+func Consistently(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	poll, err := newPoller(actual, expected)
+	if err != nil {
+		return err
+	}
+
+	for {
+		err = poll.attempt()
+		if errors.Is(err, ErrAssertionFailure) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if !poll.wait() {
+			return nil
+		}
+	}
+
+	return failure("\n"+
+		"  failed after: %v (on attempt %d)\n"+
+		"%s",
+		poll.elapsed(),
+		poll.attempts,
+		describeFailure("failure", err),
+	)
+}
+
+// poller invokes a func and applies an assertion to the value it
+// returns, at regular intervals, until a deadline has passed.
+type poller struct {
+	actual    reflect.Value
+	assertion Assertion
+	expected  []interface{}
+	interval  time.Duration
+	started   time.Time
+	deadline  time.Time
+	attempts  int
+}
+
+func newPoller(actual interface{}, expected []interface{}) (*poller, error) {
+	err := validateExpectedAtLeast(3, expected)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateKind(actual, reflect.Func)
+	if err != nil {
+		return nil, err
+	}
+
+	f := reflect.ValueOf(actual)
+	if f.IsNil() || f.Type().NumIn() != 0 || f.Type().NumOut() != 1 {
+		return nil, wrap(ErrTypeMismatch, "got %v, want func() T", f.Type())
+	}
+
+	err = validateType(expected[0], time.Duration(0))
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateType(expected[1], time.Duration(0))
+	if err != nil {
+		return nil, err
+	}
+
+	interval := expected[1].(time.Duration)
+	if interval <= 0 {
+		return nil, wrap(ErrInvalidArgument, "got interval of %v, want a positive duration", interval)
+	}
+
+	assertion, ok := asAssertion(expected[2])
+	if !ok {
+		return nil, wrap(ErrTypeMismatch, "got %T, want an assertion", expected[2])
+	}
+
+	started := time.Now()
+	return &poller{
+		actual:    f,
+		assertion: assertion,
+		expected:  expected[3:],
+		interval:  interval,
+		started:   started,
+		deadline:  started.Add(expected[0].(time.Duration)),
+	}, nil
+}
+
+func (this *poller) attempt() error {
+	this.attempts++
+	value := this.actual.Call(nil)[0].Interface()
+	return this.assertion(value, this.expected...)
+}
+
+// wait sleeps until the next attempt is due (or until the deadline, if
+// sooner, so that a final attempt is made at the deadline), reporting
+// false (without sleeping) if the deadline has passed.
+func (this *poller) wait() bool {
+	remaining := time.Until(this.deadline)
+	if remaining <= 0 {
+		return false
+	}
+	if remaining < this.interval {
+		time.Sleep(remaining)
+	} else {
+		time.Sleep(this.interval)
+	}
+	return true
+}
+
+func (this *poller) elapsed() time.Duration {
+	return time.Since(this.started).Round(time.Millisecond)
+}
@@ -0,0 +1,84 @@
+package should_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+// counter returns a func reporting how many times it has been called.
+func counter() func() interface{} {
+	var count int64
+	return func() interface{} { return atomic.AddInt64(&count, 1) }
+}
+
+func TestShouldEventually(t *testing.T) {
+	assert := NewAssertion(t)
+	interval := time.Millisecond
+
+	assert.ExpectedCountInvalid(counter(), should.Eventually, time.Second, interval)
+	assert.KindMismatch(1, should.Eventually, time.Second, interval, should.Equal, 1)
+	assert.TypeMismatch(func() {}, should.Eventually, time.Second, interval, should.Equal, 1)
+	assert.TypeMismatch(func(int) int { return 0 }, should.Eventually, time.Second, interval, should.Equal, 1)
+	assert.TypeMismatch(counter(), should.Eventually, 1, interval, should.Equal, 1)
+	assert.TypeMismatch(counter(), should.Eventually, time.Second, 1, should.Equal, 1)
+	assert.InvalidArgument(counter(), should.Eventually, time.Second, time.Duration(0), should.Equal, 1)
+	assert.InvalidArgument(counter(), should.Consistently, time.Second, -interval, should.Equal, 1)
+	assert.TypeMismatch(counter(), should.Eventually, time.Second, interval, "should.Equal", 1)
+
+	assert.Pass(counter(), should.Eventually, time.Second, interval, should.Equal, 1)
+	assert.Pass(counter(), should.Eventually, time.Second, interval, should.Equal, 3)
+	assert.Pass(func() int { return 1 }, should.Eventually, time.Second, interval, should.Equal, 1)
+	assert.Pass(counter(), should.Eventually, time.Second, interval, should.Bind(should.BeGreaterThan, 2))
+	assert.Fail(counter(), should.Eventually, time.Millisecond*20, interval, should.BeLessThan, 0)
+	assert.KindMismatch(counter(), should.Eventually, time.Second, interval, should.HaveLength, 0)
+}
+
+func TestShouldEventuallyReportsLastFailure(t *testing.T) {
+	err := should.Eventually(counter(), time.Millisecond*30, time.Millisecond*10, should.Equal, 0)
+
+	assertReportContains(t, err,
+		"gave up after: ",
+		" attempts)",
+		"last failure:\n    Expected: (int)",
+	)
+}
+
+func TestShouldEventuallyAttemptsAtLeastOnce(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.Pass(counter(), should.Eventually, time.Duration(0), time.Second, should.Equal, 1)
+	assert.Fail(counter(), should.Eventually, time.Duration(0), time.Second, should.Equal, 2)
+}
+
+func TestShouldEventuallyMakesFinalAttemptAtDeadline(t *testing.T) {
+	timeout := time.Millisecond * 50
+	started := time.Now()
+	expired := func() interface{} { return time.Since(started) >= timeout }
+
+	err := should.Eventually(expired, timeout, time.Millisecond*40, should.BeTrue)
+	if err != nil {
+		t.Error("expected a final (successful) attempt at the deadline:", err)
+	}
+}
+
+func TestShouldConsistently(t *testing.T) {
+	assert := NewAssertion(t)
+	interval := time.Millisecond
+
+	assert.ExpectedCountInvalid(counter(), should.Consistently, time.Second)
+	assert.KindMismatch(1, should.Consistently, time.Second, interval, should.Equal, 1)
+
+	assert.Pass(counter(), should.Consistently, time.Millisecond*20, interval, should.BeGreaterThan, 0)
+	assert.Fail(counter(), should.Consistently, time.Millisecond*20, interval, should.BeLessThan, 3)
+	assert.Fail(counter(), should.Consistently, time.Millisecond*20, interval, should.Equal, 2)
+	assert.KindMismatch(counter(), should.Consistently, time.Second, interval, should.HaveLength, 0)
+}
+
+func TestShouldConsistentlyReportsFirstFailure(t *testing.T) {
+	err := should.Consistently(counter(), time.Second, time.Millisecond, should.BeLessThan, 3)
+
+	assertReportContains(t, err, "(on attempt 3)", "failure:\n    ")
+}
@@ -12,6 +12,24 @@ func validateExpected(count int, expected []interface{}) error {
 	return wrap(ErrExpectedCountInvalid, "got %d value%s, want %d", length, s, count)
 }
 
+func validateExpectedRange(min, max int, expected []interface{}) error {
+	length := len(expected)
+	if min <= length && length <= max {
+		return nil
+	}
+
+	return wrap(ErrExpectedCountInvalid, "got %d value%s, want %d-%d", length, pluralize(length), min, max)
+}
+
+func validateExpectedAtLeast(min int, expected []interface{}) error {
+	length := len(expected)
+	if length >= min {
+		return nil
+	}
+
+	return wrap(ErrExpectedCountInvalid, "got %d value%s, want at least %d", length, pluralize(length), min)
+}
+
 func pluralize(count int) string {
 	if count == 1 {
 		return ""
@@ -0,0 +1,310 @@
+package should
+
+import (
+	"errors"
+	"time"
+)
+
+// HappenBefore verifies that the time.Time provided as actual
+// is strictly before the time.Time provided as expected[0].
+func HappenBefore(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	a, b, err := validateTimes(1, actual, expected)
+	if err != nil {
+		return err
+	}
+
+	if a.Before(b[0]) {
+		return nil
+	}
+
+	return failure("got %s, want before %s (missed by %s)", a, b[0], a.Sub(b[0]))
+}
+
+// HappenBefore (negated!)
+func (negated) HappenBefore(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = HappenBefore(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	a, b := actual.(time.Time), expected[0].(time.Time)
+	return failure("got %s, want not before %s (missed by %s)", a, b, b.Sub(a))
+}
+
+// HappenOnOrBefore verifies that the time.Time provided as actual
+// is before or equal to the time.Time provided as expected[0].
+func HappenOnOrBefore(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	a, b, err := validateTimes(1, actual, expected)
+	if err != nil {
+		return err
+	}
+
+	if !a.After(b[0]) {
+		return nil
+	}
+
+	return failure("got %s, want on or before %s (missed by %s)", a, b[0], a.Sub(b[0]))
+}
+
+// HappenOnOrBefore (negated!)
+func (negated) HappenOnOrBefore(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = HappenOnOrBefore(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	a, b := actual.(time.Time), expected[0].(time.Time)
+	return failure("got %s, want after %s (missed by %s)", a, b, b.Sub(a))
+}
+
+// HappenAfter verifies that the time.Time provided as actual
+// is strictly after the time.Time provided as expected[0].
+func HappenAfter(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	a, b, err := validateTimes(1, actual, expected)
+	if err != nil {
+		return err
+	}
+
+	if a.After(b[0]) {
+		return nil
+	}
+
+	return failure("got %s, want after %s (missed by %s)", a, b[0], b[0].Sub(a))
+}
+
+// HappenAfter (negated!)
+func (negated) HappenAfter(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = HappenAfter(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	a, b := actual.(time.Time), expected[0].(time.Time)
+	return failure("got %s, want not after %s (missed by %s)", a, b, a.Sub(b))
+}
+
+// HappenOnOrAfter verifies that the time.Time provided as actual
+// is after or equal to the time.Time provided as expected[0].
+func HappenOnOrAfter(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	a, b, err := validateTimes(1, actual, expected)
+	if err != nil {
+		return err
+	}
+
+	if !a.Before(b[0]) {
+		return nil
+	}
+
+	return failure("got %s, want on or after %s (missed by %s)", a, b[0], b[0].Sub(a))
+}
+
+// HappenOnOrAfter (negated!)
+func (negated) HappenOnOrAfter(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = HappenOnOrAfter(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	a, b := actual.(time.Time), expected[0].(time.Time)
+	return failure("got %s, want before %s (missed by %s)", a, b, a.Sub(b))
+}
+
+// HappenWithin verifies that the time.Time provided as actual is no more
+// than the time.Duration provided as expected[0] away from (before or
+// after) the time.Time provided as expected[1].
+func HappenWithin(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(2, expected)
+	if err != nil {
+		return err
+	}
+
+	err = validateType(expected[0], time.Duration(0))
+	if err != nil {
+		return err
+	}
+
+	a, b, err := validateTimes(1, actual, expected[1:])
+	if err != nil {
+		return err
+	}
+
+	tolerance := expected[0].(time.Duration)
+	offset := absDuration(a.Sub(b[0]))
+	if offset <= tolerance {
+		return nil
+	}
+
+	return failure("got %s, want within %s of %s (off by %s, missed by %s)",
+		a, tolerance, b[0], offset, offset-tolerance)
+}
+
+// HappenWithin (negated!)
+func (negated) HappenWithin(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = HappenWithin(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	a := actual.(time.Time)
+	tolerance, b := expected[0].(time.Duration), expected[1].(time.Time)
+	offset := absDuration(a.Sub(b))
+	return failure("got %s, want not within %s of %s (off by %s, missed by %s)",
+		a, tolerance, b, offset, tolerance-offset)
+}
+
+// HappenBetween verifies that the time.Time provided as actual is on or
+// after expected[0] and on or before expected[1] (both time.Time values).
+func HappenBetween(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	a, b, err := validateTimes(2, actual, expected)
+	if err != nil {
+		return err
+	}
+
+	earliest, latest := b[0], b[1]
+	if a.Before(earliest) {
+		return failure("got %s, want between %s and %s (too early by %s)", a, earliest, latest, earliest.Sub(a))
+	}
+	if a.After(latest) {
+		return failure("got %s, want between %s and %s (too late by %s)", a, earliest, latest, a.Sub(latest))
+	}
+	return nil
+}
+
+// HappenBetween (negated!)
+func (negated) HappenBetween(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = HappenBetween(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	a := actual.(time.Time)
+	earliest, latest := expected[0].(time.Time), expected[1].(time.Time)
+	return failure("got %s, want outside %s and %s (%s after start, %s before end)",
+		a, earliest, latest, a.Sub(earliest), latest.Sub(a))
+}
+
+// HappenOnSameDay verifies that the time.Time provided as actual falls on
+// the same calendar day as the time.Time provided as expected[0]. Both are
+// considered in the *time.Location provided as expected[1] or, when
+// omitted, in the location of expected[0].
+func HappenOnSameDay(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpectedRange(1, 2, expected)
+	if err != nil {
+		return err
+	}
+
+	a, b, err := validateTimes(1, actual, expected[:1])
+	if err != nil {
+		return err
+	}
+
+	location := b[0].Location()
+	if len(expected) == 2 {
+		err = validateType(expected[1], time.UTC)
+		if err != nil {
+			return err
+		}
+		location = expected[1].(*time.Location)
+		if location == nil {
+			return wrap(ErrTypeMismatch, "got a nil *time.Location, want a location")
+		}
+	}
+
+	days := daysApart(a.In(location), b[0].In(location))
+	if days == 0 {
+		return nil
+	}
+
+	return failure("got %s, want same day as %s in %s (missed by %d day%s)",
+		a.In(location), b[0].In(location), location, days, pluralize(days))
+}
+
+// HappenOnSameDay (negated!)
+func (negated) HappenOnSameDay(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = HappenOnSameDay(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("got %s, want a different day than %s", actual, expected[0])
+}
+
+func validateTimes(count int, actual interface{}, expected []interface{}) (time.Time, []time.Time, error) {
+	err := validateExpected(count, expected)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	err = validateType(actual, time.Time{})
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	times := make([]time.Time, 0, count)
+	for _, e := range expected {
+		err = validateType(e, time.Time{})
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		times = append(times, e.(time.Time))
+	}
+
+	return actual.(time.Time), times, nil
+}
+
+// daysApart counts the calendar days between a and b (as seen in their
+// respective locations), regardless of which comes first.
+func daysApart(a, b time.Time) int {
+	aDay := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	bDay := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(absDuration(aDay.Sub(bDay)) / (24 * time.Hour))
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
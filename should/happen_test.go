@@ -0,0 +1,127 @@
+package should_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+var (
+	noon      = time.Date(2021, time.March, 14, 12, 0, 0, 0, time.UTC)
+	oneBefore = noon.Add(-time.Second)
+	oneAfter  = noon.Add(time.Second)
+)
+
+func TestShouldHappenBefore(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(noon, should.HappenBefore)
+	assert.ExpectedCountInvalid(noon, should.HappenBefore, noon, "EXTRA")
+	assert.TypeMismatch("noon", should.HappenBefore, noon)
+	assert.TypeMismatch(noon, should.HappenBefore, "noon")
+
+	assert.Pass(oneBefore, should.HappenBefore, noon)
+	assert.Fail(noon, should.HappenBefore, noon)
+	assert.Fail(oneAfter, should.HappenBefore, noon)
+
+	assert.Fail(oneBefore, should.NOT.HappenBefore, noon)
+	assert.Pass(noon, should.NOT.HappenBefore, noon)
+}
+
+func TestShouldHappenOnOrBefore(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(noon, should.HappenOnOrBefore)
+	assert.TypeMismatch(noon, should.HappenOnOrBefore, "noon")
+
+	assert.Pass(oneBefore, should.HappenOnOrBefore, noon)
+	assert.Pass(noon, should.HappenOnOrBefore, noon.In(time.Local))
+	assert.Fail(oneAfter, should.HappenOnOrBefore, noon)
+
+	assert.Fail(noon, should.NOT.HappenOnOrBefore, noon)
+	assert.Pass(oneAfter, should.NOT.HappenOnOrBefore, noon)
+}
+
+func TestShouldHappenAfter(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(noon, should.HappenAfter)
+	assert.TypeMismatch(noon, should.HappenAfter, "noon")
+
+	assert.Pass(oneAfter, should.HappenAfter, noon)
+	assert.Fail(noon, should.HappenAfter, noon)
+	assert.Fail(oneBefore, should.HappenAfter, noon)
+
+	assert.Fail(oneAfter, should.NOT.HappenAfter, noon)
+	assert.Pass(noon, should.NOT.HappenAfter, noon)
+}
+
+func TestShouldHappenOnOrAfter(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(noon, should.HappenOnOrAfter)
+	assert.TypeMismatch(noon, should.HappenOnOrAfter, "noon")
+
+	assert.Pass(oneAfter, should.HappenOnOrAfter, noon)
+	assert.Pass(noon, should.HappenOnOrAfter, noon)
+	assert.Fail(oneBefore, should.HappenOnOrAfter, noon)
+
+	assert.Fail(noon, should.NOT.HappenOnOrAfter, noon)
+	assert.Pass(oneBefore, should.NOT.HappenOnOrAfter, noon)
+}
+
+func TestShouldHappenWithin(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(noon, should.HappenWithin, time.Second)
+	assert.ExpectedCountInvalid(noon, should.HappenWithin, time.Second, noon, "EXTRA")
+	assert.TypeMismatch(noon, should.HappenWithin, 1, noon)
+	assert.TypeMismatch(noon, should.HappenWithin, time.Second, "noon")
+
+	assert.Pass(oneBefore, should.HappenWithin, time.Second, noon)
+	assert.Pass(oneAfter, should.HappenWithin, time.Second, noon)
+	assert.Fail(oneAfter, should.HappenWithin, time.Millisecond, noon)
+	assert.Fail(oneBefore, should.HappenWithin, time.Millisecond, noon)
+
+	assert.Fail(oneAfter, should.NOT.HappenWithin, time.Second, noon)
+	assert.Pass(oneAfter, should.NOT.HappenWithin, time.Millisecond, noon)
+}
+
+func TestShouldHappenBetween(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(noon, should.HappenBetween, oneBefore)
+	assert.TypeMismatch(noon, should.HappenBetween, oneBefore, "later")
+
+	assert.Pass(noon, should.HappenBetween, oneBefore, oneAfter)
+	assert.Pass(oneBefore, should.HappenBetween, oneBefore, oneAfter)
+	assert.Pass(oneAfter, should.HappenBetween, oneBefore, oneAfter)
+	assert.Fail(oneBefore, should.HappenBetween, noon, oneAfter)
+	assert.Fail(oneAfter, should.HappenBetween, oneBefore, noon)
+
+	assert.Fail(noon, should.NOT.HappenBetween, oneBefore, oneAfter)
+	assert.Pass(oneAfter, should.NOT.HappenBetween, oneBefore, noon)
+}
+
+func TestShouldHappenOnSameDay(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(noon, should.HappenOnSameDay)
+	assert.ExpectedCountInvalid(noon, should.HappenOnSameDay, noon, time.UTC, "EXTRA")
+	assert.TypeMismatch(noon, should.HappenOnSameDay, "noon")
+	assert.TypeMismatch(noon, should.HappenOnSameDay, noon, "UTC")
+	assert.TypeMismatch(noon, should.HappenOnSameDay, noon, (*time.Location)(nil))
+	assert.TypeMismatch(noon, should.NOT.HappenOnSameDay, noon, (*time.Location)(nil))
+
+	lateEvening := time.Date(2021, time.March, 14, 23, 0, 0, 0, time.UTC)
+	tomorrow := noon.Add(24 * time.Hour)
+	tokyo := time.FixedZone("Tokyo", 9*60*60)
+
+	assert.Pass(lateEvening, should.HappenOnSameDay, noon)
+	assert.Fail(tomorrow, should.HappenOnSameDay, noon)
+	assert.Fail(lateEvening, should.HappenOnSameDay, noon, tokyo)
+
+	assert.Fail(lateEvening, should.NOT.HappenOnSameDay, noon)
+	assert.Pass(lateEvening, should.NOT.HappenOnSameDay, noon, tokyo)
+}
@@ -0,0 +1,37 @@
+package should
+
+import "reflect"
+
+// HaveCapacity uses reflection to verify that cap(actual) == expected[0].
+// The actual value may be an array, slice, or channel.
+func HaveCapacity(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	err = validateKind(actual, kindsWithCapacity...)
+	if err != nil {
+		return err
+	}
+
+	err = validateKind(expected[0], integerKinds...)
+	if err != nil {
+		return err
+	}
+
+	expectedCapacity := reflect.ValueOf(expected[0]).Int()
+	actualCapacity := int64(reflect.ValueOf(actual).Cap())
+	if actualCapacity == expectedCapacity {
+		return nil
+	}
+
+	return failure("got capacity of %d, want %d", actualCapacity, expectedCapacity)
+}
+
+var kindsWithCapacity = []reflect.Kind{
+	reflect.Array,
+	reflect.Slice,
+	reflect.Chan,
+}
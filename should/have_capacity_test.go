@@ -0,0 +1,25 @@
+package should_test
+
+import (
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestShouldHaveCapacity(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid([]int{}, should.HaveCapacity)
+	assert.ExpectedCountInvalid([]int{}, should.HaveCapacity, 1, 2)
+	assert.KindMismatch("string", should.HaveCapacity, 0)
+	assert.KindMismatch(map[int]int{}, should.HaveCapacity, 0)
+	assert.KindMismatch([]int{}, should.HaveCapacity, "0")
+
+	assert.Pass([]int(nil), should.HaveCapacity, 0)
+	assert.Pass(make([]int, 1, 3), should.HaveCapacity, 3)
+	assert.Fail(make([]int, 1, 3), should.HaveCapacity, 1)
+	assert.Pass([2]int{}, should.HaveCapacity, 2)
+	assert.Pass(make(chan int), should.HaveCapacity, 0)
+	assert.Pass(make(chan string, 5), should.HaveCapacity, int64(5))
+	assert.Fail(make(chan string, 5), should.HaveCapacity, 4)
+}
@@ -0,0 +1,254 @@
+package should
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// HaveField results in an assertion which resolves the path within the
+// actual value and applies the provided assertion (and expected values)
+// to the value found there. The path is a series of struct field names
+// (separated by dots), slice/array indexes, and map keys (integers or
+// quoted strings), as in `Items[0].Owner.Name` or `Headers["X-Id"]`.
+// Pointers and interfaces are followed along the way. Paths that are
+// malformed or that cannot be resolved within the actual value result
+// in ErrInvalidFieldPath (rather than ErrAssertionFailure).
+func HaveField(path string, assertion Assertion, expected ...interface{}) Assertion {
+	return func(actual interface{}, more ...interface{}) (err error) {
+		defer annotate(&err, actual, more)
+		err = validateExpected(0, more)
+		if err != nil {
+			return err
+		}
+
+		value, err := resolveFieldPath(actual, path)
+		if err != nil {
+			return err
+		}
+
+		err = assertion(value, expected...)
+		if errors.Is(err, ErrAssertionFailure) {
+			return failure("\n%s", describeFailure("field "+path, err))
+		}
+		if err != nil {
+			return wrap(err, "field %s", path)
+		}
+		return nil
+	}
+}
+
+// fieldStep is a single step along a field path: a struct field
+// (.Name), a slice or array index ([0]) or a map key (["key"] or [0]).
+type fieldStep struct {
+	text   string // the step, as written in the path
+	field  string // the struct field name (if any)
+	index  int    // the (integer) index or map key
+	key    string // the (string) map key
+	quoted bool   // whether the map key was a (quoted) string
+}
+
+// resolveFieldPath finds the value located at the path within actual.
+func resolveFieldPath(actual interface{}, path string) (interface{}, error) {
+	steps, err := parseFieldPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	value := reflect.ValueOf(actual)
+	for x, step := range steps {
+		resolved := strings.Join(stepTexts(steps[:x]), "")
+		value, err = step.resolve(value)
+		if err != nil {
+			return nil, wrap(ErrInvalidFieldPath, "%s (at %q in %q)", err, resolved+step.text, path)
+		}
+	}
+	if !value.IsValid() {
+		return nil, nil
+	}
+	return value.Interface(), nil
+}
+
+func parseFieldPath(path string) (steps []fieldStep, err error) {
+	for rest := path; rest != ""; {
+		var step fieldStep
+		switch {
+		case rest[0] == '[':
+			end := closingBracket(rest)
+			if end < 0 {
+				return nil, wrap(ErrInvalidFieldPath, "unclosed bracket in %q", path)
+			}
+			step, err = parseBracket(rest[:end+1])
+			if err != nil {
+				return nil, wrap(ErrInvalidFieldPath, "%s in %q", err, path)
+			}
+			rest = rest[end+1:]
+
+		default:
+			name := strings.TrimPrefix(rest, ".")
+			if name == rest && len(steps) > 0 {
+				return nil, wrap(ErrInvalidFieldPath, "expected '.' or '[' at %q in %q", rest, path)
+			}
+			end := strings.IndexAny(name, ".[")
+			if end < 0 {
+				end = len(name)
+			}
+			if !isIdentifier(name[:end]) {
+				return nil, wrap(ErrInvalidFieldPath, "invalid field name %q in %q", name[:end], path)
+			}
+			step = fieldStep{text: "." + name[:end], field: name[:end]}
+			rest = name[end:]
+		}
+		steps = append(steps, step)
+	}
+	if len(steps) == 0 {
+		return nil, wrap(ErrInvalidFieldPath, "empty path")
+	}
+	return steps, nil
+}
+
+// closingBracket finds the index of the bracket that closes the
+// one at the start of s, skipping over any quoted string within.
+func closingBracket(s string) int {
+	if len(s) > 1 && s[1] == '"' {
+		for x := 2; x < len(s); x++ {
+			switch s[x] {
+			case '\\':
+				x++
+			case '"':
+				if x+1 < len(s) && s[x+1] == ']' {
+					return x + 1
+				}
+				return -1
+			}
+		}
+		return -1
+	}
+	return strings.IndexByte(s, ']')
+}
+
+func parseBracket(text string) (fieldStep, error) {
+	inner := text[1 : len(text)-1]
+	if strings.HasPrefix(inner, `"`) {
+		key, err := strconv.Unquote(inner)
+		if err != nil {
+			return fieldStep{}, fmt.Errorf("invalid key %s", inner)
+		}
+		return fieldStep{text: text, key: key, quoted: true}, nil
+	}
+	index, err := strconv.Atoi(inner)
+	if err != nil {
+		return fieldStep{}, fmt.Errorf("invalid index %s", text)
+	}
+	return fieldStep{text: text, index: index}, nil
+}
+
+func isIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for x, r := range name {
+		if r != '_' && !('a' <= r && r <= 'z') && !('A' <= r && r <= 'Z') && (x == 0 || !('0' <= r && r <= '9')) {
+			return false
+		}
+	}
+	return true
+}
+
+func stepTexts(steps []fieldStep) (texts []string) {
+	for _, step := range steps {
+		texts = append(texts, step.text)
+	}
+	return texts
+}
+
+// resolve applies the step to the value, following
+// any pointers and interfaces along the way.
+func (this fieldStep) resolve(value reflect.Value) (reflect.Value, error) {
+	for value.IsValid() && (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return value, fmt.Errorf("nil %s", value.Kind())
+		}
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return value, errors.New("nil value")
+	}
+
+	switch {
+	case this.field != "":
+		return this.resolveField(value)
+	case value.Kind() == reflect.Map:
+		return this.resolveKey(value)
+	case value.Kind() == reflect.Slice || value.Kind() == reflect.Array || value.Kind() == reflect.String:
+		return this.resolveIndex(value)
+	default:
+		return value, fmt.Errorf("cannot index %v", value.Type())
+	}
+}
+
+func (this fieldStep) resolveField(value reflect.Value) (reflect.Value, error) {
+	if value.Kind() != reflect.Struct {
+		return value, fmt.Errorf("%v is not a struct", value.Type())
+	}
+	field, found := value.Type().FieldByName(this.field)
+	if !found {
+		return value, fmt.Errorf("%v has no field %s", value.Type(), this.field)
+	}
+	if field.PkgPath != "" {
+		return value, fmt.Errorf("field %s of %v is unexported", this.field, value.Type())
+	}
+	// Promoted fields are reached one step at a time, as (unlike
+	// FieldByIndex) a nil embedded pointer must be reported, not panic.
+	for x, index := range field.Index {
+		if x > 0 && value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				return value, fmt.Errorf("field %s is promoted via nil embedded %v", this.field, value.Type())
+			}
+			value = value.Elem()
+		}
+		value = value.Field(index)
+	}
+	return value, nil
+}
+
+func (this fieldStep) resolveIndex(value reflect.Value) (reflect.Value, error) {
+	if this.quoted {
+		return value, fmt.Errorf("cannot index %v with a string", value.Type())
+	}
+	if this.index < 0 || this.index >= value.Len() {
+		return value, fmt.Errorf("index %d out of range (length %d)", this.index, value.Len())
+	}
+	return value.Index(this.index), nil
+}
+
+func (this fieldStep) resolveKey(value reflect.Value) (reflect.Value, error) {
+	keyType := value.Type().Key()
+	var key reflect.Value
+	switch {
+	case this.quoted && keyType.Kind() == reflect.String:
+		key = reflect.ValueOf(this.key).Convert(keyType)
+	case !this.quoted && isSignedKind(keyType.Kind()):
+		key = reflect.ValueOf(int64(this.index)).Convert(keyType)
+	case !this.quoted && this.index >= 0 && isUnsignedKind(keyType.Kind()):
+		key = reflect.ValueOf(uint64(this.index)).Convert(keyType)
+	default:
+		return value, fmt.Errorf("key %s not assignable to %v", this.text, keyType)
+	}
+	found := value.MapIndex(key)
+	if !found.IsValid() {
+		return value, fmt.Errorf("key %s not found", this.text)
+	}
+	return found, nil
+}
+
+func isUnsignedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
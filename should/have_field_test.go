@@ -0,0 +1,101 @@
+package should_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+type (
+	Owner    struct{ Name string }
+	Item     struct{ Owner *Owner }
+	Response struct {
+		Items   []Item
+		Headers map[string][]string
+		Codes   map[uint8]string
+		Meta    interface{}
+		private int
+	}
+)
+
+var response = &Response{
+	Items:   []Item{{Owner: &Owner{Name: "Alice"}}, {}},
+	Headers: map[string][]string{"X-Id": {"42"}, `Quote"d`: {"yes"}},
+	Codes:   map[uint8]string{200: "OK"},
+	Meta:    map[string]interface{}{"page": 3},
+}
+
+func TestShouldHaveField(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(response, should.HaveField("Items", should.BeEmpty), "EXTRA")
+
+	assert.Pass(response, should.HaveField("Items[0].Owner.Name", should.Equal, "Alice"))
+	assert.Pass(response, should.HaveField(".Items[0].Owner.Name", should.Equal, "Alice"))
+	assert.Fail(response, should.HaveField("Items[0].Owner.Name", should.Equal, "Bob"))
+	assert.Pass(response, should.HaveField("Items[1].Owner", should.BeNil))
+	assert.Pass(response, should.HaveField(`Headers["X-Id"][0]`, should.Equal, "42"))
+	assert.Pass(response, should.HaveField(`Headers["Quote\"d"]`, should.HaveLength, 1))
+	assert.Pass(response, should.HaveField(`Headers["X-Id"][0][1]`, should.Equal, '2'))
+	assert.Pass(response, should.HaveField("Codes[200]", should.Equal, "OK"))
+	assert.Pass(response, should.HaveField(`Meta["page"]`, should.Equal, 3))
+	assert.Pass(response, should.HaveField("Items", should.HaveLength, 2))
+	assert.ExpectedCountInvalid(response, should.HaveField("Items[0].Owner.Name", should.BeEmpty, 1))
+}
+
+func TestShouldHaveFieldRejectsInvalidPaths(t *testing.T) {
+	assert := NewAssertion(t)
+
+	for _, path := range []string{
+		"",
+		"Items[",
+		"Items[x]",
+		`Headers["X-Id]`,
+		`Headers["X-Id"`,
+		"Items..Owner",
+		"Items[0]Owner",
+		"1Items",
+		"Missing",
+		"private",
+		"Items[2]",
+		"Items[-1]",
+		"Items[1].Owner.Name",
+		`Items["0"]`,
+		`Headers["Y-Id"]`,
+		"Headers[0]",
+		"Codes[-1]",
+		`Codes["200"]`,
+		"Items[0].Owner.Name.First",
+		`Meta["page"].Value`,
+	} {
+		assert.InvalidFieldPath(response, should.HaveField(path, should.BeNil))
+	}
+	assert.InvalidFieldPath(nil, should.HaveField("Items", should.BeNil))
+}
+
+func TestShouldHaveFieldResolvesPromotedFields(t *testing.T) {
+	type Inner struct{ X int }
+	type Outer struct{ *Inner }
+	assert := NewAssertion(t)
+
+	assert.Pass(Outer{&Inner{X: 1}}, should.HaveField("X", should.Equal, 1))
+	assert.Pass(&Outer{&Inner{X: 1}}, should.HaveField("Inner.X", should.Equal, 1))
+	assert.InvalidFieldPath(Outer{}, should.HaveField("X", should.Equal, 1))
+	assert.InvalidFieldPath(Outer{}, should.HaveField("Inner.X", should.Equal, 1))
+}
+
+func TestShouldHaveFieldReportsPath(t *testing.T) {
+	err := should.HaveField("Items[0].Owner.Name", should.Equal, "Bob")(response)
+
+	assertReportContains(t, err, "field Items[0].Owner.Name:", `Expected: (string) "Bob"`)
+}
+
+func TestShouldHaveFieldReportsUnresolvedStep(t *testing.T) {
+	err := should.HaveField("Items[1].Owner.Name", should.Equal, "Bob")(response)
+
+	if errors.Is(err, should.ErrAssertionFailure) {
+		t.Error("invalid paths should not be assertion failures:", err)
+	}
+	assertReportContains(t, err, `nil ptr (at ".Items[1].Owner.Name"`)
+}
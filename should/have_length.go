@@ -3,8 +3,9 @@ package should
 import "reflect"
 
 // HaveLength uses reflection to verify that len(actual) == 0.
-func HaveLength(actual interface{}, expected ...interface{}) error {
-	err := validateExpected(1, expected)
+func HaveLength(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,208 @@
+package should
+
+import (
+	"fmt"
+	"strings"
+)
+
+// contextLines is the number of unchanged lines
+// shown on either side of each change in a hunk.
+const contextLines = 3
+
+// maxDiffCells limits the size of the (quadratic) LCS table computed by
+// diffLines. Larger regions are reported as a wholesale replacement.
+const maxDiffCells = 1 << 20
+
+// isMultiLine reports whether both values are strings
+// and at least one of them spans multiple lines.
+func isMultiLine(a, b interface{}) bool {
+	aString, aOK := a.(string)
+	bString, bOK := b.(string)
+	return aOK && bOK && (strings.Contains(aString, "\n") || strings.Contains(bString, "\n"))
+}
+
+// edit is a single line of a line-oriented diff.
+type edit struct {
+	op       byte // ' ' (common), '-' (expected only), '+' (actual only)
+	text     string
+	expected int // 1-based line number in expected (0 if absent)
+	actual   int // 1-based line number in actual (0 if absent)
+}
+
+// unifiedDiff renders a unified, line-numbered diff of two multi-line
+// strings, showing each change with some surrounding context under a
+// hunk header. Runs of unchanged lines between hunks are elided.
+func unifiedDiff(expected, actual string) string {
+	edits := diffLines(strings.Split(expected, "\n"), strings.Split(actual, "\n"))
+
+	builder := new(strings.Builder)
+	_, _ = fmt.Fprintln(builder, "--- expected")
+	_, _ = fmt.Fprintln(builder, "+++ actual")
+
+	printed := 0
+	for _, hunk := range hunks(edits) {
+		if elided := hunk[0] - printed; elided > 0 {
+			_, _ = fmt.Fprintf(builder, "... (%d identical line%s elided)\n", elided, pluralize(elided))
+		}
+		writeHunk(builder, edits[hunk[0]:hunk[1]])
+		printed = hunk[1]
+	}
+	if elided := len(edits) - printed; elided > 0 && printed > 0 {
+		_, _ = fmt.Fprintf(builder, "... (%d identical line%s elided)\n", elided, pluralize(elided))
+	}
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+// diffLines computes the shortest edit script transforming
+// expected into actual via their longest common subsequence.
+func diffLines(expected, actual []string) (edits []edit) {
+	// Trim the common prefix and suffix so the (quadratic)
+	// LCS table only covers the region that actually differs.
+	prefix := 0
+	for prefix < len(expected) && prefix < len(actual) && expected[prefix] == actual[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(expected)-prefix && suffix < len(actual)-prefix &&
+		expected[len(expected)-1-suffix] == actual[len(actual)-1-suffix] {
+		suffix++
+	}
+
+	for x := 0; x < prefix; x++ {
+		edits = append(edits, edit{op: ' ', text: expected[x], expected: x + 1, actual: x + 1})
+	}
+
+	e := expected[prefix : len(expected)-suffix]
+	a := actual[prefix : len(actual)-suffix]
+	if (len(e)+1)*(len(a)+1) > maxDiffCells {
+		for x, line := range e {
+			edits = append(edits, edit{op: '-', text: line, expected: prefix + x + 1})
+		}
+		for y, line := range a {
+			edits = append(edits, edit{op: '+', text: line, actual: prefix + y + 1})
+		}
+		return append(edits, commonSuffix(expected, actual, suffix)...)
+	}
+	lcs := make([][]int, len(e)+1)
+	for x := range lcs {
+		lcs[x] = make([]int, len(a)+1)
+	}
+	for x := len(e) - 1; x >= 0; x-- {
+		for y := len(a) - 1; y >= 0; y-- {
+			if e[x] == a[y] {
+				lcs[x][y] = lcs[x+1][y+1] + 1
+			} else if lcs[x+1][y] >= lcs[x][y+1] {
+				lcs[x][y] = lcs[x+1][y]
+			} else {
+				lcs[x][y] = lcs[x][y+1]
+			}
+		}
+	}
+	x, y := 0, 0
+	for x < len(e) || y < len(a) {
+		switch {
+		case x < len(e) && y < len(a) && e[x] == a[y]:
+			edits = append(edits, edit{op: ' ', text: e[x], expected: prefix + x + 1, actual: prefix + y + 1})
+			x++
+			y++
+		case y == len(a) || (x < len(e) && lcs[x+1][y] >= lcs[x][y+1]):
+			edits = append(edits, edit{op: '-', text: e[x], expected: prefix + x + 1})
+			x++
+		default:
+			edits = append(edits, edit{op: '+', text: a[y], actual: prefix + y + 1})
+			y++
+		}
+	}
+
+	return append(edits, commonSuffix(expected, actual, suffix)...)
+}
+
+// commonSuffix lists the (unchanged) final suffix lines of both.
+func commonSuffix(expected, actual []string, suffix int) (edits []edit) {
+	for z := suffix; z > 0; z-- {
+		edits = append(edits, edit{
+			op:       ' ',
+			text:     expected[len(expected)-z],
+			expected: len(expected) - z + 1,
+			actual:   len(actual) - z + 1,
+		})
+	}
+	return edits
+}
+
+// hunks groups the changes within edits into [start, end) ranges,
+// each padded by (at most) contextLines of unchanged lines. Changes
+// separated by no more than twice that many lines share a hunk.
+func hunks(edits []edit) (ranges [][2]int) {
+	for x := 0; x < len(edits); x++ {
+		if edits[x].op == ' ' {
+			continue
+		}
+		start := max(0, x-contextLines)
+		end := min(len(edits), x+contextLines+1)
+		if n := len(ranges); n > 0 && start <= ranges[n-1][1] {
+			ranges[n-1][1] = end
+		} else {
+			ranges = append(ranges, [2]int{start, end})
+		}
+	}
+	return ranges
+}
+
+func writeHunk(builder *strings.Builder, hunk []edit) {
+	expectedStart, expectedCount := 0, 0
+	actualStart, actualCount := 0, 0
+	for _, edit := range hunk {
+		if edit.expected > 0 {
+			if expectedCount == 0 {
+				expectedStart = edit.expected
+			}
+			expectedCount++
+		}
+		if edit.actual > 0 {
+			if actualCount == 0 {
+				actualStart = edit.actual
+			}
+			actualCount++
+		}
+	}
+	_, _ = fmt.Fprintf(builder, "@@ -%d,%d +%d,%d @@\n", expectedStart, expectedCount, actualStart, actualCount)
+	for _, edit := range hunk {
+		_, _ = fmt.Fprintf(builder, "%c %4s %4s | %s\n", edit.op, lineNumber(edit.expected), lineNumber(edit.actual), edit.text)
+	}
+}
+
+// numberedLines renders each line of the provided
+// string, prefixed by its (1-based) line number.
+func numberedLines(s string) string {
+	lines := strings.Split(s, "\n")
+	for x, line := range lines {
+		lines[x] = fmt.Sprintf("%4d | %s", x+1, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func countLines(s string) string {
+	count := strings.Count(s, "\n") + 1
+	return fmt.Sprintf("%d line%s", count, pluralize(count))
+}
+
+func lineNumber(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprint(n)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
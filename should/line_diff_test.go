@@ -0,0 +1,75 @@
+package should_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestEqualReportsUnifiedDiffForMultiLineStrings(t *testing.T) {
+	expected := numbered(1, 20)
+	actual := strings.Replace(expected, "line 10", "line ten", 1)
+	actual = strings.Replace(actual, "line 11\n", "", 1)
+
+	err := should.Equal(actual, expected)
+
+	assertReportContains(t, err,
+		"--- expected\n+++ actual\n",
+		"... (6 identical lines elided)\n",
+		"@@ -7,8 +7,7 @@\n",
+		"     7    7 | line 7\n",
+		"-   10      | line 10\n",
+		"-   11      | line 11\n",
+		"+        10 | line ten\n",
+		"    12   11 | line 12\n",
+	)
+	if !strings.HasSuffix(err.Error(), "... (6 identical lines elided)") {
+		t.Error("the unchanged trailing lines should be elided:", err)
+	}
+}
+
+func TestEqualReportsSeparateHunksForDistantChanges(t *testing.T) {
+	expected := numbered(1, 30)
+	actual := strings.Replace(expected, "line 2\n", "line two\n", 1)
+	actual = strings.Replace(actual, "line 28", "line twenty-eight", 1)
+
+	err := should.Equal(actual, expected)
+
+	assertReportContains(t, err,
+		"@@ -1,5 +1,5 @@\n",
+		"... (19 identical lines elided)\n",
+		"@@ -25,6 +25,6 @@\n",
+	)
+}
+
+func TestNotEqualReportsNumberedLinesForMultiLineStrings(t *testing.T) {
+	err := should.NOT.Equal("a\nb", "a\nb")
+
+	assertReportContains(t, err, "   1 | a\n   2 | b")
+}
+
+func numbered(from, to int) string {
+	var lines []string
+	for x := from; x <= to; x++ {
+		lines = append(lines, "line "+strconv.Itoa(x))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestEqualReportsLargeChangesAsReplacement(t *testing.T) {
+	expected := numbered(1, 2000)
+	actual := strings.Replace(expected, "line 1\n", "line one\n", 1)
+	actual = strings.Replace(actual, "line 2000", "line two thousand", 1)
+
+	err := should.Equal(actual, expected)
+
+	assertReportContains(t, err,
+		"@@ -1,2000 +1,2000 @@\n",
+		"-    1      | line 1\n",
+		"-    2      | line 2\n",
+		"+         1 | line one\n",
+		"+      2000 | line two thousand",
+	)
+}
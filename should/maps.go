@@ -0,0 +1,294 @@
+package should
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ContainKey verifies that the map provided as actual has a key
+// that is Equal to expected[0].
+func ContainKey(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	err = validateKind(actual, reflect.Map)
+	if err != nil {
+		return err
+	}
+
+	if _, found := lookup(actual, expected[0]); found {
+		return nil
+	}
+
+	return failure("\n"+
+		"  key absent:  %s\n"+
+		"  nearby keys: %s",
+		Format(expected[0]),
+		nearbyKeys(actual, expected[0]),
+	)
+}
+
+// ContainKey (negated!)
+func (negated) ContainKey(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = ContainKey(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	value, _ := lookup(actual, expected[0])
+	return failure("\n"+
+		"  key present: %s\n"+
+		"  with value:  %s",
+		Format(expected[0]),
+		Format(value),
+	)
+}
+
+// ContainValue verifies that the map provided as actual has (at least)
+// one value that is Equal to expected[0], under any key.
+func ContainValue(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	err = validateKind(actual, reflect.Map)
+	if err != nil {
+		return err
+	}
+
+	if keys := keysOf(actual, expected[0]); len(keys) > 0 {
+		return nil
+	}
+
+	return failure("\n"+
+		"  value absent: %s\n"+
+		"  within:       %s",
+		Format(expected[0]),
+		Format(actual),
+	)
+}
+
+// ContainValue (negated!)
+func (negated) ContainValue(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = ContainValue(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("\n"+
+		"  value present: %s\n"+
+		"  under keys:    %s",
+		Format(expected[0]),
+		formatKeys(keysOf(actual, expected[0])),
+	)
+}
+
+// ContainEntry verifies that the map provided as actual has a key that
+// is Equal to expected[0] under which it holds a value that is Equal to
+// expected[1].
+func ContainEntry(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(2, expected)
+	if err != nil {
+		return err
+	}
+
+	err = validateKind(actual, reflect.Map)
+	if err != nil {
+		return err
+	}
+
+	mismatch := entryMismatch(actual, expected[0], expected[1])
+	if mismatch == "" {
+		return nil
+	}
+
+	return failure("%s", mismatch)
+}
+
+// ContainEntry (negated!)
+func (negated) ContainEntry(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = ContainEntry(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("\n"+
+		"  entry present: %s: %s",
+		Format(expected[0]),
+		Format(expected[1]),
+	)
+}
+
+// ContainSubmap verifies that the map provided as actual contains every
+// entry of the map provided as expected[0] (keys and values being compared
+// with Equal). The actual map may also contain other entries.
+func ContainSubmap(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	err = validateKind(actual, reflect.Map)
+	if err != nil {
+		return err
+	}
+
+	err = validateKind(expected[0], reflect.Map)
+	if err != nil {
+		return err
+	}
+
+	var mismatches []string
+	submap := reflect.ValueOf(expected[0])
+	for _, key := range sortedKeys(submap) {
+		mismatch := entryMismatch(actual, key.Interface(), submap.MapIndex(key).Interface())
+		if mismatch != "" {
+			mismatches = append(mismatches, mismatch)
+		}
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	return failure("%s", strings.Join(mismatches, ""))
+}
+
+// ContainSubmap (negated!)
+func (negated) ContainSubmap(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = ContainSubmap(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("\n"+
+		"  submap present: %s\n"+
+		"  within:         %s",
+		Format(expected[0]),
+		Format(actual),
+	)
+}
+
+// entryMismatch describes the way in which the map (m) fails to
+// contain an entry of the key and value (or is blank if it doesn't).
+func entryMismatch(m, key, value interface{}) string {
+	found, ok := lookup(m, key)
+	if !ok {
+		return fmt.Sprintf("\n"+
+			"  key absent:  %s (want value %s)\n"+
+			"  nearby keys: %s",
+			Format(key),
+			Format(value),
+			nearbyKeys(m, key),
+		)
+	}
+
+	if equal(found, value) {
+		return ""
+	}
+
+	return fmt.Sprintf("\n"+
+		"  under key:   %s\n"+
+		"  found value: %s\n"+
+		"  want value:  %s",
+		Format(key),
+		Format(found),
+		Format(value),
+	)
+}
+
+// lookup finds the value stored in the map (m) under the key,
+// comparing keys via Equal when not of the map's key type.
+func lookup(m, key interface{}) (interface{}, bool) {
+	value := reflect.ValueOf(m)
+	if key != nil && reflect.TypeOf(key) == value.Type().Key() {
+		found := value.MapIndex(reflect.ValueOf(key))
+		if found.IsValid() {
+			return found.Interface(), true
+		}
+	}
+	iterator := value.MapRange()
+	for iterator.Next() {
+		if equal(iterator.Key().Interface(), key) {
+			return iterator.Value().Interface(), true
+		}
+	}
+	return nil, false
+}
+
+// keysOf lists the keys of the map (m) under which
+// a value that is Equal to the provided value is found.
+func keysOf(m, value interface{}) (keys []reflect.Value) {
+	iterator := reflect.ValueOf(m).MapRange()
+	for iterator.Next() {
+		if equal(iterator.Value().Interface(), value) {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	return keys
+}
+
+// nearbyKeys renders the keys of the map (m) that would
+// sort (see compareKeys) nearest to the key.
+func nearbyKeys(m, key interface{}) string {
+	value := reflect.ValueOf(m)
+	keys := sortedKeys(value)
+	target := reflect.ValueOf(key)
+	position := sort.Search(len(keys), func(i int) bool {
+		return compareKeys(keys[i], target) >= 0
+	})
+	start := max(0, position-nearbyKeyCount)
+	end := min(len(keys), position+nearbyKeyCount)
+	rendered := formatKeys(keys[start:end])
+	if start > 0 {
+		rendered = fmt.Sprintf("(%d more) ", start) + rendered
+	}
+	if end < len(keys) {
+		rendered += fmt.Sprintf(" (%d more)", len(keys)-end)
+	}
+	return rendered
+}
+
+func formatKeys(keys []reflect.Value) string {
+	sort.Slice(keys, func(i, j int) bool {
+		return compareKeys(keys[i], keys[j]) < 0
+	})
+	rendered := make([]string, len(keys))
+	for x, key := range keys {
+		rendered[x] = formatLine(key)
+	}
+	return "[" + strings.Join(rendered, ", ") + "]"
+}
+
+// nearbyKeyCount is the number of keys listed
+// on either side of a missing key's position.
+const nearbyKeyCount = 3
@@ -0,0 +1,112 @@
+package should_test
+
+import (
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+var inventory = map[string]int{"apple": 1, "banana": 2, "cherry": 3, "date": 2}
+
+func TestShouldContainKey(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(inventory, should.ContainKey)
+	assert.ExpectedCountInvalid(inventory, should.ContainKey, "apple", "EXTRA")
+	assert.KindMismatch([]string{"apple"}, should.ContainKey, "apple")
+
+	assert.Pass(inventory, should.ContainKey, "apple")
+	assert.Pass(map[int64]bool{1: true}, should.ContainKey, 1)
+	assert.Fail(inventory, should.ContainKey, "blueberry")
+	assert.Fail(map[string]int(nil), should.ContainKey, "apple")
+
+	assert.Fail(inventory, should.NOT.ContainKey, "apple")
+	assert.Pass(inventory, should.NOT.ContainKey, "blueberry")
+}
+
+func TestShouldContainKeyReportsNearbyKeys(t *testing.T) {
+	keys := map[int]bool{}
+	for x := 10; x < 30; x += 2 {
+		keys[x] = true
+	}
+
+	err := should.ContainKey(keys, 19)
+
+	assertReportContains(t, err, "nearby keys: (2 more) [14, 16, 18, 20, 22, 24] (2 more)")
+}
+
+func TestShouldContainKeyReportsNearbyKeysByValue(t *testing.T) {
+	keys := map[int]bool{}
+	for x := 1; x <= 12; x++ {
+		keys[x] = true
+	}
+	delete(keys, 3)
+
+	err := should.ContainKey(keys, int64(3))
+	assertReportContains(t, err, "nearby keys: [1, 2, 4, 5, 6] (6 more)")
+
+	err = should.ContainKey(map[float64]bool{-1.5: true, 0.25: true, 10: true, 2: true}, 1.0)
+	assertReportContains(t, err, "nearby keys: [-1.5, 0.25, 2, 10]")
+}
+
+func TestShouldContainValue(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(inventory, should.ContainValue)
+	assert.KindMismatch([]int{1}, should.ContainValue, 1)
+
+	assert.Pass(inventory, should.ContainValue, 2)
+	assert.Pass(inventory, should.ContainValue, uint(3))
+	assert.Fail(inventory, should.ContainValue, 4)
+
+	assert.Fail(inventory, should.NOT.ContainValue, 2)
+	assert.Pass(inventory, should.NOT.ContainValue, 4)
+}
+
+func TestShouldContainEntry(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(inventory, should.ContainEntry, "apple")
+	assert.KindMismatch([]int{1}, should.ContainEntry, 0, 1)
+
+	assert.Pass(inventory, should.ContainEntry, "apple", 1)
+	assert.Fail(inventory, should.ContainEntry, "apple", 2)
+	assert.Fail(inventory, should.ContainEntry, "blueberry", 1)
+
+	assert.Fail(inventory, should.NOT.ContainEntry, "apple", 1)
+	assert.Pass(inventory, should.NOT.ContainEntry, "apple", 2)
+}
+
+func TestShouldContainEntryReportsValueFound(t *testing.T) {
+	err := should.ContainEntry(inventory, "banana", 3)
+
+	assertReportContains(t, err, "found value: 2\n  want value:  3")
+}
+
+func TestShouldContainSubmap(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(inventory, should.ContainSubmap)
+	assert.KindMismatch([]int{1}, should.ContainSubmap, map[string]int{})
+	assert.KindMismatch(inventory, should.ContainSubmap, []int{1})
+
+	assert.Pass(inventory, should.ContainSubmap, map[string]int{})
+	assert.Pass(inventory, should.ContainSubmap, map[string]int{"apple": 1, "date": 2})
+	assert.Pass(inventory, should.ContainSubmap, map[string]interface{}{"apple": 1.0})
+	assert.Fail(inventory, should.ContainSubmap, map[string]int{"apple": 1, "date": 3})
+	assert.Fail(inventory, should.ContainSubmap, map[string]int{"apple": 1, "fig": 1})
+
+	assert.Fail(inventory, should.NOT.ContainSubmap, map[string]int{"apple": 1})
+	assert.Pass(inventory, should.NOT.ContainSubmap, map[string]int{"apple": 2})
+}
+
+func TestShouldContainSubmapChecksEntriesWithKeysFormattedAlike(t *testing.T) {
+	assert := NewAssertion(t)
+
+	a, b := 1, 1
+	actual := map[*int]int{&a: 1, &b: 1}
+
+	assert.Pass(actual, should.ContainSubmap, map[*int]int{&a: 1, &b: 1})
+	assert.Fail(actual, should.ContainSubmap, map[*int]int{&a: 1, &b: 2})
+	assert.Fail(actual, should.ContainSubmap, map[*int]int{&b: 1, new(int): 1})
+}
@@ -0,0 +1,198 @@
+package should
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// MatchFields results in an assertion which verifies the fields of the
+// struct (or pointer to struct) provided as actual against the expected
+// value, which may be either:
+//   - a map whose (string) keys are field paths (see HaveField), or
+//   - a struct of the same type as actual, whose non-zero fields are verified.
+//
+// Each expected field value may be a literal (compared with Equal), an
+// Assertion (applied to the field's value with no expected values; see
+// Bind), or a nested map or struct (matched in the same, partial manner).
+// Fields not mentioned by the expected value are ignored.
+func MatchFields(expected interface{}) Assertion {
+	return matchFields(expected, false)
+}
+
+// MatchFieldsStrictly is like MatchFields, but also requires that the
+// fields not mentioned by the expected value hold their zero value.
+func MatchFieldsStrictly(expected interface{}) Assertion {
+	return matchFields(expected, true)
+}
+
+func matchFields(expected interface{}, strict bool) Assertion {
+	return func(actual interface{}, more ...interface{}) (err error) {
+		defer annotate(&err, actual, more)
+		err = validateExpected(0, more)
+		if err != nil {
+			return err
+		}
+
+		mismatches, err := fieldMismatches("", actual, expected, strict)
+		if err != nil {
+			return err
+		}
+		if len(mismatches) == 0 {
+			return nil
+		}
+
+		return failure("%d mismatched field%s:\n%s",
+			len(mismatches),
+			pluralize(len(mismatches)),
+			strings.Join(mismatches, "\n"),
+		)
+	}
+}
+
+// fieldMismatches describes each field of the actual struct (located
+// at the path) which fails to match the corresponding expected value.
+func fieldMismatches(path string, actual, expected interface{}, strict bool) (mismatches []string, err error) {
+	actualValue := indirect(reflect.ValueOf(actual))
+	if actualValue.Kind() != reflect.Struct {
+		return nil, wrap(ErrKindMismatch, "got %s, want struct (or pointer to struct)", actualValue.Kind())
+	}
+
+	mentioned := make(map[string]bool)
+	expectedValue := indirect(reflect.ValueOf(expected))
+	switch {
+	case expectedValue.Kind() == reflect.Map && expectedValue.Type().Key().Kind() == reflect.String:
+		keys := expectedValue.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, key := range keys {
+			steps, err := parseFieldPath(key.String())
+			if err != nil {
+				return nil, err
+			}
+			mentioned[steps[0].field] = true
+			value, err := resolveFieldPath(actualValue.Interface(), key.String())
+			if err != nil {
+				return nil, err
+			}
+			mismatches, err = appendFieldMismatch(mismatches, joinFieldPath(path, key.String()), value, expectedValue.MapIndex(key).Interface(), strict)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+	case expectedValue.Kind() == reflect.Struct && expectedValue.Type() == actualValue.Type():
+		for x := 0; x < expectedValue.NumField(); x++ {
+			field := expectedValue.Type().Field(x)
+			if field.PkgPath != "" || expectedValue.Field(x).IsZero() {
+				continue
+			}
+			mentioned[field.Name] = true
+			mismatches, err = appendFieldMismatch(mismatches, joinFieldPath(path, field.Name), actualValue.Field(x).Interface(), expectedValue.Field(x).Interface(), strict)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+	default:
+		return nil, wrap(ErrTypeMismatch, "got %v, want map[string]... or %v", typeOf(expectedValue), actualValue.Type())
+	}
+
+	if strict {
+		for x := 0; x < actualValue.NumField(); x++ {
+			field := actualValue.Type().Field(x)
+			if field.PkgPath != "" || mentioned[field.Name] || actualValue.Field(x).IsZero() {
+				continue
+			}
+			mismatches = append(mismatches, fmt.Sprintf(""+
+				"  field %s:\n"+
+				"    unmentioned field should be zero, got %s",
+				joinFieldPath(path, field.Name),
+				Format(actualValue.Field(x).Interface()),
+			))
+		}
+	}
+	return mismatches, nil
+}
+
+// appendFieldMismatch matches the actual value of the field (at the path)
+// against the expected value, appending any mismatches to those provided.
+func appendFieldMismatch(mismatches []string, path string, actual, expected interface{}, strict bool) ([]string, error) {
+	if isFieldMatcher(actual, expected) {
+		nested, err := fieldMismatches(path, actual, expected, strict)
+		return append(mismatches, nested...), err
+	}
+
+	var err error
+	if assertion, ok := asAssertion(expected); ok {
+		err = assertion(actual)
+	} else {
+		err = Equal(actual, expected)
+	}
+	if errors.Is(err, ErrAssertionFailure) {
+		return append(mismatches, describeFailure("field "+path, err)), nil
+	}
+	if err != nil {
+		return nil, wrap(err, "field %s", path)
+	}
+	return mismatches, nil
+}
+
+// isFieldMatcher reports whether the expected value (a map with string
+// keys or a struct of the same type as actual) should be matched against
+// the fields of actual (a struct or pointer to struct) rather than Equal.
+func isFieldMatcher(actual, expected interface{}) bool {
+	actualValue := indirect(reflect.ValueOf(actual))
+	expectedValue := indirect(reflect.ValueOf(expected))
+	if actualValue.Kind() != reflect.Struct || isTime(actualValue.Interface()) {
+		return false
+	}
+	switch expectedValue.Kind() {
+	case reflect.Map:
+		return expectedValue.Type().Key().Kind() == reflect.String
+	case reflect.Struct:
+		return expectedValue.Type() == actualValue.Type()
+	default:
+		return false
+	}
+}
+
+// asAssertion converts the value to an Assertion, if it is a (non-nil)
+// func of the same shape (even one of a named type, like assert.Assertion).
+func asAssertion(v interface{}) (Assertion, bool) {
+	if assertion, ok := v.(Assertion); ok {
+		return assertion, assertion != nil
+	}
+	value := reflect.ValueOf(v)
+	assertionType := reflect.TypeOf(Assertion(nil))
+	if value.Kind() != reflect.Func || value.IsNil() || !value.Type().ConvertibleTo(assertionType) {
+		return nil, false
+	}
+	return value.Convert(assertionType).Interface().(Assertion), true
+}
+
+// indirect follows (non-nil) pointers and interfaces.
+func indirect(value reflect.Value) reflect.Value {
+	for (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) && !value.IsNil() {
+		value = value.Elem()
+	}
+	return value
+}
+
+func joinFieldPath(path, step string) string {
+	if path == "" {
+		return strings.TrimPrefix(step, ".")
+	}
+	if strings.HasPrefix(step, "[") {
+		return path + step
+	}
+	return path + "." + strings.TrimPrefix(step, ".")
+}
+
+func typeOf(value reflect.Value) interface{} {
+	if !value.IsValid() {
+		return "<nil>"
+	}
+	return value.Type()
+}
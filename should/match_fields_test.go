@@ -0,0 +1,105 @@
+package should_test
+
+import (
+	"testing"
+
+	"github.com/mdwhatcott/testing/assert"
+	"github.com/mdwhatcott/testing/should"
+)
+
+type Account struct {
+	ID      string
+	Name    string
+	Balance float64
+	Owner   *Owner
+	Tags    []string
+	hidden  int
+}
+
+// namedEmpty is an assertion of a named (rather than func) type.
+var namedEmpty assert.Assertion = should.BeEmpty
+
+var account = Account{
+	ID:      "acct-8a7f",
+	Name:    "Savings",
+	Balance: 10.5,
+	Owner:   &Owner{Name: "Alice"},
+	hidden:  1,
+}
+
+func TestShouldMatchFields(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(account, should.MatchFields(map[string]interface{}{}), "EXTRA")
+	assert.KindMismatch(42, should.MatchFields(map[string]interface{}{}))
+	assert.TypeMismatch(account, should.MatchFields(42))
+	assert.TypeMismatch(account, should.MatchFields(Owner{}))
+	assert.InvalidFieldPath(account, should.MatchFields(map[string]interface{}{"Missing": 1}))
+
+	// maps:
+	assert.Pass(account, should.MatchFields(map[string]interface{}{}))
+	assert.Pass(account, should.MatchFields(map[string]interface{}{"Name": "Savings", "Balance": 10.5}))
+	assert.Pass(&account, should.MatchFields(map[string]interface{}{"Owner.Name": "Alice"}))
+	assert.Pass(account, should.MatchFields(map[string]string{"Name": "Savings"}))
+	assert.Fail(account, should.MatchFields(map[string]interface{}{"Name": "Checking"}))
+
+	// assertions:
+	assert.Pass(account, should.MatchFields(map[string]interface{}{"ID": should.Bind(should.StartWith, "acct-")}))
+	assert.Pass(account, should.MatchFields(map[string]interface{}{"ID": should.NOT.BeEmpty}))
+	assert.Pass(account, should.MatchFields(map[string]interface{}{"Tags": namedEmpty}))
+	assert.Fail(account, should.MatchFields(map[string]interface{}{"ID": should.BeEmpty}))
+	assert.ExpectedCountInvalid(account, should.MatchFields(map[string]interface{}{"ID": should.StartWith}))
+
+	// nested:
+	assert.Pass(account, should.MatchFields(map[string]interface{}{"Owner": map[string]interface{}{"Name": "Alice"}}))
+	assert.Pass(account, should.MatchFields(map[string]interface{}{"Owner": Owner{Name: "Alice"}}))
+	assert.Fail(account, should.MatchFields(map[string]interface{}{"Owner": map[string]interface{}{"Name": "Bob"}}))
+
+	// structs:
+	assert.Pass(account, should.MatchFields(Account{Name: "Savings"}))
+	assert.Pass(&account, should.MatchFields(&Account{Name: "Savings", Owner: &Owner{}}))
+	assert.Fail(account, should.MatchFields(Account{Name: "Savings", Balance: 1}))
+}
+
+func TestShouldMatchFieldsStrictly(t *testing.T) {
+	assert := NewAssertion(t)
+
+	everything := map[string]interface{}{
+		"ID":         should.NOT.BeEmpty,
+		"Name":       "Savings",
+		"Balance":    10.5,
+		"Owner.Name": "Alice",
+	}
+	assert.Pass(account, should.MatchFieldsStrictly(everything))
+	assert.Fail(account, should.MatchFieldsStrictly(map[string]interface{}{"Name": "Savings"}))
+	assert.Pass(account, should.MatchFieldsStrictly(Account{
+		ID:      "acct-8a7f",
+		Name:    "Savings",
+		Balance: 10.5,
+		Owner:   &Owner{Name: "Alice"},
+	}))
+	assert.Fail(Account{Tags: []string{"a"}}, should.MatchFieldsStrictly(Account{}))
+}
+
+func TestShouldMatchFieldsReportsEachMismatchedField(t *testing.T) {
+	err := should.MatchFieldsStrictly(map[string]interface{}{
+		"ID":      should.Bind(should.StartWith, "user-"),
+		"Name":    "Checking",
+		"Balance": 10.5,
+	})(account)
+
+	assertReportContains(t, err,
+		"3 mismatched fields:",
+		"field ID:\n    proposed prefix",
+		"field Name:\n    Expected: (string) \"Checking\"",
+		"field Owner:\n    unmentioned field should be zero",
+	)
+}
+
+func TestShouldMatchFieldsReportsNestedPaths(t *testing.T) {
+	err := should.MatchFields(map[string]interface{}{
+		"Owner": map[string]interface{}{"Name": "Bob"},
+	})(account)
+
+	assertReportContains(t, err, "field Owner.Name:")
+}
@@ -0,0 +1,174 @@
+package should
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MatchGlob verifies that actual (a string, []byte, or fmt.Stringer) is
+// matched in its entirety by the glob pattern (a string) provided as
+// expected[0]. Within the pattern:
+//   - '*' matches any sequence of characters (including '/' and newlines),
+//   - '?' matches any single character (including a newline),
+//   - '[abc]', '[a-z]', and '[!abc]' match (or exclude) a character class,
+//   - '\' escapes the character that follows it.
+func MatchGlob(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	text, err := textOf(actual)
+	if err != nil {
+		return err
+	}
+
+	err = validateType(expected[0], "")
+	if err != nil {
+		return err
+	}
+
+	tokens, err := parseGlob(expected[0].(string))
+	if err != nil {
+		return err
+	}
+
+	if compileGlob(tokens).MatchString(text) {
+		return nil
+	}
+
+	return failure("\n"+
+		"  text: %q\n"+
+		"  glob: %s\n"+
+		"  %s",
+		text,
+		expected[0],
+		closestGlobMatch(tokens, text),
+	)
+}
+
+// MatchGlob (negated!)
+func (negated) MatchGlob(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = MatchGlob(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	text, _ := textOf(actual)
+	return failure("\n"+
+		"  text: %q\n"+
+		"  glob: %s\n"+
+		"  (should not have matched, but it did)",
+		text,
+		expected[0],
+	)
+}
+
+// globToken is an element of a glob pattern (as written), along
+// with the (fragment of a) regular expression that it translates to.
+type globToken struct {
+	glob  string
+	regex string
+}
+
+// parseGlob breaks a glob pattern into its elements.
+func parseGlob(glob string) (tokens []globToken, err error) {
+	runes := []rune(glob)
+	for x := 0; x < len(runes); x++ {
+		start := x
+		var regex string
+		switch r := runes[x]; r {
+		case '*':
+			regex = ".*"
+		case '?':
+			regex = "."
+		case '\\':
+			if x+1 == len(runes) {
+				return nil, wrap(ErrInvalidPattern, "glob %q ends with an unescaped '\\'", glob)
+			}
+			x++
+			regex = regexp.QuoteMeta(string(runes[x]))
+		case '[':
+			end := x + 1
+			if end < len(runes) && runes[end] == '!' {
+				end++
+			}
+			if end < len(runes) && runes[end] == ']' {
+				end++ // a leading ']' is a literal member of the class
+			}
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, wrap(ErrInvalidPattern, "glob %q has an unterminated character class", glob)
+			}
+			class := string(runes[x+1 : end])
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			regex = "[" + strings.ReplaceAll(class, `\`, `\\`) + "]"
+			x = end
+		default:
+			regex = regexp.QuoteMeta(string(r))
+		}
+		tokens = append(tokens, globToken{glob: string(runes[start : x+1]), regex: regex})
+	}
+
+	_, err = regexp.Compile(translateGlob(tokens))
+	if err != nil {
+		return nil, wrap(ErrInvalidPattern, "glob %q: %s", glob, err)
+	}
+	return tokens, nil
+}
+
+// translateGlob renders the (parsed) glob pattern as a regular expression
+// which is anchored at the start, and in which '*' and '?' match newlines.
+func translateGlob(tokens []globToken) string {
+	builder := new(strings.Builder)
+	builder.WriteString("(?s)^")
+	for _, token := range tokens {
+		builder.WriteString(token.regex)
+	}
+	return builder.String()
+}
+
+// compileGlob compiles the (parsed, and so valid) glob pattern into
+// a regular expression which must match the entire text.
+func compileGlob(tokens []globToken) *regexp.Regexp {
+	return regexp.MustCompile(translateGlob(tokens) + "$")
+}
+
+// closestGlobMatch describes the longest leading portion of the glob
+// pattern which matches (the start of) the text, in terms of the glob.
+func closestGlobMatch(tokens []globToken, text string) string {
+	for k := len(tokens) - 1; k > 0; k-- {
+		location := regexp.MustCompile(translateGlob(tokens[:k])).FindStringIndex(text)
+		if location == nil {
+			continue
+		}
+		matched := text[:location[1]]
+		return fmt.Sprintf(""+
+			"closest partial match: %s matched %q,\n"+
+			"  but the rest of the glob (%s) failed to match at offset %d: %q",
+			joinGlob(tokens[:k]), matched,
+			joinGlob(tokens[k:]), len(matched), text[len(matched):],
+		)
+	}
+	return "(no partial match)"
+}
+
+func joinGlob(tokens []globToken) string {
+	builder := new(strings.Builder)
+	for _, token := range tokens {
+		builder.WriteString(token.glob)
+	}
+	return builder.String()
+}
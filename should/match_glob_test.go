@@ -0,0 +1,50 @@
+package should_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestShouldMatchGlob(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid("actual", should.MatchGlob)
+	assert.TypeMismatch(42, should.MatchGlob, "*")
+	assert.TypeMismatch("actual", should.MatchGlob, 42)
+	assert.InvalidPattern("actual", should.MatchGlob, "[a")
+	assert.InvalidPattern("actual", should.MatchGlob, `a\`)
+
+	assert.Pass("main_test.go", should.MatchGlob, "*_test.go")
+	assert.Pass("a/b/c.txt", should.MatchGlob, "a/*.txt")
+	assert.Pass("file.go", should.MatchGlob, "fil?.[gh]o")
+	assert.Pass("file.so", should.MatchGlob, "file.[!g]o")
+	assert.Pass("what?", should.MatchGlob, `what\?`)
+	assert.Fail("file.go", should.MatchGlob, "file.[!g]o")
+	assert.Fail("main.go", should.MatchGlob, "*_test.go")
+	assert.Fail("xmain.go", should.MatchGlob, "main.go")
+
+	assert.Fail("main.go", should.NOT.MatchGlob, "*.go")
+	assert.Pass("main.go", should.NOT.MatchGlob, "*.txt")
+}
+
+func TestShouldMatchGlobAcrossLines(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.Pass("line1\nline2", should.MatchGlob, "line1*")
+	assert.Pass("a\nb", should.MatchGlob, "a?b")
+	assert.Fail("a\nb", should.MatchGlob, "a?")
+}
+
+func TestShouldMatchGlobReportsClosestPartialMatchAsGlob(t *testing.T) {
+	err := should.MatchGlob("service_test.txt", "*_test.go")
+
+	assertReportContains(t, err,
+		`closest partial match: *_test. matched "service_test.",`,
+		`but the rest of the glob (go) failed to match at offset 13: "txt"`,
+	)
+	if strings.Contains(err.Error(), `\A`) || strings.Contains(err.Error(), "(?") {
+		t.Error("the partial match should be described in terms of the glob:", err)
+	}
+}
@@ -0,0 +1,147 @@
+package should
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MatchRegex verifies that actual (a string, []byte, or fmt.Stringer) is
+// matched by the regular expression provided as expected[0] (a pattern
+// string or *regexp.Regexp).
+func MatchRegex(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	text, err := textOf(actual)
+	if err != nil {
+		return err
+	}
+
+	pattern, err := compilePattern(expected[0])
+	if err != nil {
+		return err
+	}
+
+	if pattern.MatchString(text) {
+		return nil
+	}
+
+	return failure("\n"+
+		"  text:    %q\n"+
+		"  pattern: %s\n"+
+		"  %s",
+		text,
+		pattern,
+		closestPartialMatch(pattern, text),
+	)
+}
+
+// MatchRegex (negated!)
+func (negated) MatchRegex(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = MatchRegex(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	text, _ := textOf(actual)
+	pattern, _ := compilePattern(expected[0])
+	location := pattern.FindStringIndex(text)
+	return failure("\n"+
+		"  text:    %q\n"+
+		"  pattern: %s\n"+
+		"  should not have matched, but it matched %q at offset %d",
+		text,
+		pattern,
+		text[location[0]:location[1]],
+		location[0],
+	)
+}
+
+// MatchRegexCaptures verifies that actual (a string, []byte, or fmt.Stringer)
+// is matched by the regular expression provided as expected[0] (a pattern
+// string or *regexp.Regexp) and that the named submatches of the (leftmost)
+// match equal the values provided in expected[1] (a map[string]string of
+// group names to submatches).
+func MatchRegexCaptures(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(2, expected)
+	if err != nil {
+		return err
+	}
+
+	text, err := textOf(actual)
+	if err != nil {
+		return err
+	}
+
+	pattern, err := compilePattern(expected[0])
+	if err != nil {
+		return err
+	}
+
+	err = validateType(expected[1], map[string]string{})
+	if err != nil {
+		return err
+	}
+
+	captures := expected[1].(map[string]string)
+	groups := make(map[string]int)
+	for x, name := range pattern.SubexpNames() {
+		if name != "" {
+			groups[name] = x
+		}
+	}
+
+	names := make([]string, 0, len(captures))
+	for name := range captures {
+		if _, found := groups[name]; !found {
+			return wrap(ErrInvalidPattern, "pattern %s has no group named %q", pattern, name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	submatches := pattern.FindStringSubmatch(text)
+	if submatches == nil {
+		return failure("\n"+
+			"  text:    %q\n"+
+			"  pattern: %s\n"+
+			"  %s",
+			text,
+			pattern,
+			closestPartialMatch(pattern, text),
+		)
+	}
+
+	var mismatches []string
+	for _, name := range names {
+		got, want := submatches[groups[name]], captures[name]
+		if got != want {
+			mismatches = append(mismatches, fmt.Sprintf("  (?P<%s>): got %q, want %q", name, got, want))
+		}
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	return failure("\n"+
+		"  text:    %q\n"+
+		"  pattern: %s\n"+
+		"  matched: %q\n"+
+		"%s",
+		text,
+		pattern,
+		submatches[0],
+		strings.Join(mismatches, "\n"),
+	)
+}
@@ -0,0 +1,51 @@
+package should_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestShouldMatchRegex(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid("actual", should.MatchRegex)
+	assert.ExpectedCountInvalid("actual", should.MatchRegex, "a", "EXTRA")
+	assert.TypeMismatch(42, should.MatchRegex, "a")
+	assert.TypeMismatch("actual", should.MatchRegex, 42)
+	assert.InvalidPattern("actual", should.MatchRegex, "(")
+
+	assert.Pass("actual", should.MatchRegex, "^act")
+	assert.Pass([]byte("actual"), should.MatchRegex, regexp.MustCompile("t+u"))
+	assert.Pass(time.Second, should.MatchRegex, `^\ds$`)
+	assert.Fail("actual", should.MatchRegex, "^tua")
+
+	assert.Fail("actual", should.NOT.MatchRegex, "^act")
+	assert.Pass("actual", should.NOT.MatchRegex, "^tua")
+}
+
+func TestShouldMatchRegexReportsClosestPartialMatch(t *testing.T) {
+	err := should.MatchRegex("order-1234-x", `order-\d+-\d+`)
+
+	assertReportContains(t, err,
+		`closest partial match: order-[0-9]+- matched "order-1234-" at offset 0`,
+		`([0-9]+) failed to match at offset 11: "x"`,
+	)
+}
+
+func TestShouldMatchRegexCaptures(t *testing.T) {
+	assert := NewAssertion(t)
+
+	date := `(?P<year>\d{4})-(?P<month>\d{2})-(?P<day>\d{2})`
+
+	assert.ExpectedCountInvalid("2021-03-14", should.MatchRegexCaptures, date)
+	assert.TypeMismatch(42, should.MatchRegexCaptures, date, map[string]string{})
+	assert.TypeMismatch("2021-03-14", should.MatchRegexCaptures, date, map[string]int{})
+	assert.InvalidPattern("2021-03-14", should.MatchRegexCaptures, date, map[string]string{"hour": "12"})
+
+	assert.Pass("on 2021-03-14", should.MatchRegexCaptures, date, map[string]string{"year": "2021", "day": "14"})
+	assert.Fail("on 2021-03-14", should.MatchRegexCaptures, date, map[string]string{"year": "2020", "day": "14"})
+	assert.Fail("on 2021-3-14", should.MatchRegexCaptures, date, map[string]string{"year": "2021"})
+}
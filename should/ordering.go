@@ -0,0 +1,270 @@
+package should
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// BeGreaterThan verifies that actual is greater than expected[0].
+// Both values must be numeric (of any kind, including time.Duration),
+// strings, or time.Time values.
+func BeGreaterThan(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	comparison, err := compareOrdered(actual, expected[0])
+	if err != nil {
+		return err
+	}
+
+	if comparison != unordered && comparison > 0 {
+		return nil
+	}
+
+	return failure("got %s, want greater than %s", formatOrdered(actual), formatOrdered(expected[0]))
+}
+
+// BeGreaterThanOrEqualTo verifies that actual is greater than or equal to
+// expected[0]. See BeGreaterThan for the types of values that are supported.
+func BeGreaterThanOrEqualTo(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	comparison, err := compareOrdered(actual, expected[0])
+	if err != nil {
+		return err
+	}
+
+	if comparison != unordered && comparison >= 0 {
+		return nil
+	}
+
+	return failure("got %s, want greater than or equal to %s", formatOrdered(actual), formatOrdered(expected[0]))
+}
+
+// BeLessThan verifies that actual is less than expected[0].
+// See BeGreaterThan for the types of values that are supported.
+func BeLessThan(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	comparison, err := compareOrdered(actual, expected[0])
+	if err != nil {
+		return err
+	}
+
+	if comparison != unordered && comparison < 0 {
+		return nil
+	}
+
+	return failure("got %s, want less than %s", formatOrdered(actual), formatOrdered(expected[0]))
+}
+
+// BeLessThanOrEqualTo verifies that actual is less than or equal to
+// expected[0]. See BeGreaterThan for the types of values that are supported.
+func BeLessThanOrEqualTo(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	comparison, err := compareOrdered(actual, expected[0])
+	if err != nil {
+		return err
+	}
+
+	if comparison != unordered && comparison <= 0 {
+		return nil
+	}
+
+	return failure("got %s, want less than or equal to %s", formatOrdered(actual), formatOrdered(expected[0]))
+}
+
+// BeBetween verifies that actual is greater than expected[0] and less than
+// expected[1] (exclusive bounds). See BeGreaterThan for the types of values
+// that are supported.
+func BeBetween(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	lower, upper, err := compareBounds(actual, expected)
+	if err != nil {
+		return err
+	}
+
+	if lower != unordered && lower > 0 && upper < 0 {
+		return nil
+	}
+
+	return failure("got %s, want between %s and %s (exclusive)",
+		formatOrdered(actual), formatOrdered(expected[0]), formatOrdered(expected[1]))
+}
+
+// BeBetweenOrEqual verifies that actual is greater than or equal to
+// expected[0] and less than or equal to expected[1] (inclusive bounds).
+// See BeGreaterThan for the types of values that are supported.
+func BeBetweenOrEqual(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	lower, upper, err := compareBounds(actual, expected)
+	if err != nil {
+		return err
+	}
+
+	if lower != unordered && lower >= 0 && upper <= 0 {
+		return nil
+	}
+
+	return failure("got %s, want between %s and %s (inclusive)",
+		formatOrdered(actual), formatOrdered(expected[0]), formatOrdered(expected[1]))
+}
+
+func compareBounds(actual interface{}, expected []interface{}) (lower, upper int, err error) {
+	err = validateExpected(2, expected)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lower, err = compareOrdered(actual, expected[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	upper, err = compareOrdered(actual, expected[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if lower == unordered || upper == unordered {
+		return unordered, unordered, nil
+	}
+
+	return lower, upper, nil
+}
+
+// compareOrdered returns -1, 0, or +1 depending on whether a is less than,
+// equal to, or greater than b (or unordered, when either is NaN). Numerics
+// of differing kinds are compared by value, as with Equal.
+func compareOrdered(a, b interface{}) (int, error) {
+	if isTime(a) && isTime(b) {
+		return compareTimes(a.(time.Time), b.(time.Time)), nil
+	}
+	if isTime(a) || isTime(b) {
+		return 0, validateType(a, b)
+	}
+
+	err := validateKind(a, orderedKinds...)
+	if err != nil {
+		return 0, err
+	}
+
+	err = validateKind(b, orderedKinds...)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case isNumeric(a) && isNumeric(b):
+		return compareNumerics(reflect.ValueOf(a), reflect.ValueOf(b)), nil
+	case isString(a) && isString(b):
+		return strings.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String()), nil
+	default:
+		return 0, validateType(a, b)
+	}
+}
+
+// unordered is the result of comparing NaN with any value
+// (which satisfies none of the ordering assertions).
+const unordered = 2
+
+func compareTimes(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareNumerics compares numeric values of any kind. Integers are
+// compared exactly (accounting for signedness), while comparisons that
+// involve a floating point value are carried out with float64 precision.
+func compareNumerics(a, b reflect.Value) int {
+	switch {
+	case isFloatKind(a.Kind()) || isFloatKind(b.Kind()):
+		return compareFloats(asFloat64(a), asFloat64(b))
+	case isSignedKind(a.Kind()) && isSignedKind(b.Kind()):
+		return compareInts(a.Int(), b.Int())
+	case !isSignedKind(a.Kind()) && !isSignedKind(b.Kind()):
+		return compareUints(a.Uint(), b.Uint())
+	case isSignedKind(a.Kind()):
+		if a.Int() < 0 {
+			return -1
+		}
+		return compareUints(uint64(a.Int()), b.Uint())
+	default:
+		if b.Int() < 0 {
+			return 1
+		}
+		return compareUints(a.Uint(), uint64(b.Int()))
+	}
+}
+func compareFloats(a, b float64) int {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return unordered
+	}
+	return compareSign(a < b, a > b)
+}
+func compareInts(a, b int64) int {
+	return compareSign(a < b, a > b)
+}
+func compareUints(a, b uint64) int {
+	return compareSign(a < b, a > b)
+}
+func compareSign(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+func isSignedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+func isString(v interface{}) bool {
+	return reflect.TypeOf(v).Kind() == reflect.String
+}
+
+func formatOrdered(v interface{}) string {
+	if isTime(v) {
+		return v.(time.Time).String()
+	}
+	return formatValue(reflect.ValueOf(v))
+}
+
+// orderedKinds lists the kinds supported by the ordering assertions,
+// along with time.Time values (which are handled separately).
+var orderedKinds = append([]reflect.Kind{reflect.String}, numericKindList...)
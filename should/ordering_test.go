@@ -0,0 +1,134 @@
+package should_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestShouldBeGreaterThan(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1, should.BeGreaterThan)
+	assert.ExpectedCountInvalid(1, should.BeGreaterThan, 1, "EXTRA")
+
+	assert.KindMismatch(true, should.BeGreaterThan, false)
+	assert.KindMismatch(1, should.BeGreaterThan, []int{0})
+	assert.TypeMismatch(1, should.BeGreaterThan, "0")
+	assert.TypeMismatch(time.Now(), should.BeGreaterThan, 0)
+
+	assert.Pass(2, should.BeGreaterThan, 1)
+	assert.Fail(1, should.BeGreaterThan, 1)
+	assert.Fail(0, should.BeGreaterThan, 1)
+
+	// cross-kind numerics:
+	assert.Pass(uint8(2), should.BeGreaterThan, int64(-1))
+	assert.Fail(int64(-1), should.BeGreaterThan, uint64(math.MaxUint64))
+	assert.Pass(uint64(math.MaxUint64), should.BeGreaterThan, int64(math.MaxInt64))
+	assert.Pass(2.5, should.BeGreaterThan, 2)
+	assert.Fail(2, should.BeGreaterThan, 2.5)
+
+	assert.Pass("b", should.BeGreaterThan, "a")
+	assert.Fail("a", should.BeGreaterThan, "b")
+
+	assert.Pass(time.Minute, should.BeGreaterThan, time.Second)
+	assert.Fail(time.Second, should.BeGreaterThan, time.Minute)
+
+	assert.Pass(oneAfter, should.BeGreaterThan, noon)
+	assert.Fail(noon, should.BeGreaterThan, oneAfter)
+}
+
+func TestShouldBeGreaterThanOrEqualTo(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1, should.BeGreaterThanOrEqualTo)
+	assert.KindMismatch(true, should.BeGreaterThanOrEqualTo, false)
+	assert.TypeMismatch("1", should.BeGreaterThanOrEqualTo, 1)
+
+	assert.Pass(2, should.BeGreaterThanOrEqualTo, 1)
+	assert.Pass(1, should.BeGreaterThanOrEqualTo, 1.0)
+	assert.Fail(0, should.BeGreaterThanOrEqualTo, 1)
+	assert.Pass("a", should.BeGreaterThanOrEqualTo, "a")
+	assert.Pass(noon, should.BeGreaterThanOrEqualTo, noon.In(time.Local))
+}
+
+func TestShouldBeLessThan(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1, should.BeLessThan)
+	assert.KindMismatch(true, should.BeLessThan, false)
+	assert.TypeMismatch("1", should.BeLessThan, 1)
+
+	assert.Pass(1, should.BeLessThan, 2)
+	assert.Fail(1, should.BeLessThan, 1)
+	assert.Pass(int8(-1), should.BeLessThan, uint(0))
+	assert.Pass("a", should.BeLessThan, "b")
+	assert.Pass(time.Second, should.BeLessThan, time.Minute)
+	assert.Pass(oneBefore, should.BeLessThan, noon)
+}
+
+func TestShouldBeLessThanOrEqualTo(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1, should.BeLessThanOrEqualTo)
+	assert.KindMismatch(true, should.BeLessThanOrEqualTo, false)
+	assert.TypeMismatch("1", should.BeLessThanOrEqualTo, 1)
+
+	assert.Pass(1, should.BeLessThanOrEqualTo, 2)
+	assert.Pass(1, should.BeLessThanOrEqualTo, 1)
+	assert.Fail(2, should.BeLessThanOrEqualTo, 1)
+}
+
+func TestShouldBeBetween(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1, should.BeBetween, 0)
+	assert.ExpectedCountInvalid(1, should.BeBetween, 0, 2, "EXTRA")
+	assert.KindMismatch(true, should.BeBetween, 0, 2)
+	assert.TypeMismatch(1, should.BeBetween, 0, "2")
+
+	assert.Pass(1, should.BeBetween, 0, 2)
+	assert.Fail(0, should.BeBetween, 0, 2)
+	assert.Fail(2, should.BeBetween, 0, 2)
+	assert.Pass("b", should.BeBetween, "a", "c")
+	assert.Pass(noon, should.BeBetween, oneBefore, oneAfter)
+}
+
+func TestShouldBeBetweenOrEqual(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1, should.BeBetweenOrEqual, 0)
+	assert.KindMismatch(true, should.BeBetweenOrEqual, 0, 2)
+
+	assert.Pass(0, should.BeBetweenOrEqual, 0, 2)
+	assert.Pass(2, should.BeBetweenOrEqual, 0, 2)
+	assert.Fail(3, should.BeBetweenOrEqual, 0, 2)
+	assert.Pass(time.Second, should.BeBetweenOrEqual, time.Second, time.Minute)
+}
+
+func TestOrderingFailsForNaN(t *testing.T) {
+	assert := NewAssertion(t)
+	nan := math.NaN()
+
+	for _, ordering := range []func(interface{}, ...interface{}) error{
+		should.BeGreaterThan,
+		should.BeGreaterThanOrEqualTo,
+		should.BeLessThan,
+		should.BeLessThanOrEqualTo,
+	} {
+		assert.Fail(nan, ordering, 1.0)
+		assert.Fail(1.0, ordering, nan)
+		assert.Fail(nan, ordering, 1)
+		assert.Fail(nan, ordering, nan)
+	}
+	for _, between := range []func(interface{}, ...interface{}) error{
+		should.BeBetween,
+		should.BeBetweenOrEqual,
+	} {
+		assert.Fail(nan, between, 0.0, 1.0)
+		assert.Fail(0.5, between, nan, 1.0)
+		assert.Fail(0.5, between, 0.0, nan)
+	}
+}
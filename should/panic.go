@@ -1,47 +1,212 @@
 package should
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+)
 
 // Panic invokes the func() provided as actual and recovers from any
 // panic. It returns an error if actual() does not result in a panic.
+// A func() that calls runtime.Goexit (as *testing.T.FailNow does) has
+// not panicked: the calling goroutine exits, ending the test as usual,
+// rather than the assertion reporting a result (and this holds for the
+// other Panic assertions as well).
 func Panic(actual interface{}, expected ...interface{}) (err error) {
-	err = NOT.Panic(actual, expected...)
-	if errors.Is(err, ErrAssertionFailure) {
+	defer annotate(&err, actual, expected)
+	outcome, err := invoke(0, actual, expected)
+	if err != nil {
+		return err
+	}
+
+	if outcome.panicked {
 		return nil
 	}
 
+	return outcome.unexpected("a panic")
+}
+
+// Panic (negated!) expects the func() provided as actual to run without panicking.
+func (negated) Panic(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	outcome, err := invoke(0, actual, expected)
+	if err != nil {
+		return err
+	}
+
+	if outcome.panicked {
+		return failure(""+
+			"provided func should not have panicked but it did with: %s\n"+
+			"panic stack:\n%s",
+			Format(outcome.recovered),
+			outcome.stack,
+		)
+	}
+
+	return nil
+}
+
+// PanicWith invokes the func() provided as actual and verifies that it
+// panics with a value that is Equal to expected[0].
+func PanicWith(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	outcome, err := invoke(1, actual, expected)
 	if err != nil {
 		return err
 	}
 
-	return failure("" +
-		"provided func did not panic as expected " +
-		"(...or it panicked with a <nil> value...)",
+	if !outcome.panicked {
+		return outcome.unexpected("a panic with " + Format(expected[0]))
+	}
+
+	if equal(outcome.recovered, expected[0]) {
+		return nil
+	}
+
+	return failure(""+
+		"provided func panicked with: %s\n"+
+		"          but should have: %s\n"+
+		"panic stack:\n%s",
+		Format(outcome.recovered),
+		Format(expected[0]),
+		outcome.stack,
 	)
 }
 
-// Panic (negated!) expects the func() provided as actual to run without panicking.
-func (negated) Panic(actual interface{}, expected ...interface{}) (err error) {
-	err = validateExpected(0, expected)
+// PanicWithError invokes the func() provided as actual and verifies that
+// it panics with an error value which wraps expected[0] (see errors.Is).
+func PanicWithError(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	outcome, err := invoke(1, actual, expected)
 	if err != nil {
 		return err
 	}
 
-	err = validateType(actual, func() {})
+	inner, ok := expected[0].(error)
+	if !ok {
+		return errTypeMismatch(expected[0])
+	}
+
+	if !outcome.panicked {
+		return outcome.unexpected(fmt.Sprintf("a panic with an error wrapping (%s)", inner))
+	}
+
+	recovered, ok := outcome.recovered.(error)
+	if ok && errors.Is(recovered, inner) {
+		return nil
+	}
+
+	chain := "\t(not an error)"
+	if ok {
+		chain = errorChain(recovered)
+	}
+
+	return failure(""+
+		"provided func panicked with: (%T) %v\n"+
+		"but should have wrapped:     (%s)\n"+
+		"error chain:\n%s\n"+
+		"panic stack:\n%s",
+		outcome.recovered,
+		outcome.recovered,
+		inner,
+		chain,
+		outcome.stack,
+	)
+}
+
+// PanicMatching invokes the func() provided as actual and verifies that it
+// panics with a value whose message (as rendered by fmt.Sprint) matches the
+// regular expression provided as expected[0] (a string or *regexp.Regexp).
+func PanicMatching(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	outcome, err := invoke(1, actual, expected)
 	if err != nil {
 		return err
 	}
 
+	pattern, err := compilePattern(expected[0])
+	if err != nil {
+		return err
+	}
+
+	if !outcome.panicked {
+		return outcome.unexpected(fmt.Sprintf("a panic matching %s", pattern))
+	}
+
+	message := fmt.Sprint(outcome.recovered)
+	if pattern.MatchString(message) {
+		return nil
+	}
+
+	return failure(""+
+		"provided func panicked with: %q\n"+
+		"but should have matched:     %s\n"+
+		"panic stack:\n%s",
+		message,
+		pattern,
+		outcome.stack,
+	)
+}
+
+func invoke(count int, actual interface{}, expected []interface{}) (outcome panicOutcome, err error) {
+	err = validateExpected(count, expected)
+	if err != nil {
+		return outcome, err
+	}
+
+	err = validateType(actual, func() {})
+	if err != nil {
+		return outcome, err
+	}
+
+	return catchPanic(actual.(func())), nil
+}
+
+// panicOutcome describes the manner in which a func() terminated.
+type panicOutcome struct {
+	panicked  bool        // the func panicked (possibly with a <nil> value)
+	recovered interface{} // the value passed to panic
+	stack     string      // the stack of the panicking goroutine, from the panic site
+}
+
+func (this panicOutcome) unexpected(want string) error {
+	return failure("provided func returned normally, want %s", want)
+}
+
+// catchPanic invokes f, recovering from any panic (even a panic with a
+// <nil> value, which is told apart from a normal return by whether f
+// returned). A call to runtime.Goexit cannot be recovered from, so it
+// proceeds to terminate the calling goroutine.
+func catchPanic(f func()) (outcome panicOutcome) {
+	returned := false
 	defer func() {
-		r := recover()
-		if r != nil {
-			err = failure(""+
-				"provided func should not have"+
-				"panicked but it did with: %s", r,
-			)
+		if !returned {
+			outcome.panicked = true
+			outcome.recovered = recover()
+			outcome.stack = panicStack(debug.Stack())
 		}
 	}()
+	f()
+	returned = true
+	return outcome
+}
 
-	actual.(func())()
-	return nil
+// panicStack trims the frames preceding the call to panic (those of
+// the deferred, recovering func) and those from catchPanic onward
+// (those of the assertion and its callers) from stack.
+func panicStack(stack []byte) string {
+	lines := strings.Split(strings.TrimSpace(string(stack)), "\n")
+	for x, line := range lines {
+		if strings.HasPrefix(line, packagePath+".catchPanic(") {
+			lines = lines[:x]
+			break
+		}
+	}
+	for x, line := range lines {
+		if strings.HasPrefix(line, "panic(") && x+2 < len(lines) {
+			return "> " + strings.Join(lines[x+2:], "\n> ")
+		}
+	}
+	return "> " + strings.Join(lines, "\n> ")
 }
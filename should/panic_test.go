@@ -1,6 +1,11 @@
 package should_test
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/mdwhatcott/testing/should"
@@ -14,6 +19,7 @@ func TestShouldPanic(t *testing.T) {
 
 	assert.Fail(func() {}, should.Panic)
 	assert.Pass(func() { panic("yay") }, should.Panic)
+	assert.Pass(func() { panic(nil) }, should.Panic)
 }
 
 func TestShouldNotPanic(t *testing.T) {
@@ -25,3 +31,73 @@ func TestShouldNotPanic(t *testing.T) {
 	assert.Fail(func() { panic("boo") }, should.NOT.Panic)
 	assert.Pass(func() {}, should.NOT.Panic)
 }
+
+func TestShouldNotPanicReportsPanicSite(t *testing.T) {
+	err := should.NOT.Panic(panicky)
+
+	assertReportContains(t, err, "panic stack:\n> github.com/danyloB/Testing/should_test.panicky()")
+	if strings.Contains(err.Error(), "catchPanic") {
+		t.Error("the panic stack should end at the panic site:", err)
+	}
+}
+
+func TestPanicAssertionsLetGoexitTerminateTheGoroutine(t *testing.T) {
+	for _, assertion := range []func() error{
+		func() error { return should.Panic(runtime.Goexit) },
+		func() error { return should.NOT.Panic(runtime.Goexit) },
+		func() error { return should.PanicWith(runtime.Goexit, "boink") },
+	} {
+		exited := make(chan bool)
+		go func() {
+			returned := false
+			defer func() { exited <- !returned }()
+			_ = assertion()
+			returned = true
+		}()
+		if !<-exited {
+			t.Error("runtime.Goexit should have terminated the calling goroutine")
+		}
+	}
+}
+
+func TestShouldPanicWith(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(panicky, should.PanicWith)
+	assert.ExpectedCountInvalid(panicky, should.PanicWith, "EXPECTED", "EXTRA")
+	assert.TypeMismatch("wrong type", should.PanicWith, "boink")
+
+	assert.Pass(panicky, should.PanicWith, "boink")
+	assert.Pass(func() { panic(42) }, should.PanicWith, uint8(42))
+	assert.Fail(panicky, should.PanicWith, "bonk")
+	assert.Fail(func() {}, should.PanicWith, "boink")
+}
+
+func TestShouldPanicWithError(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(panicky, should.PanicWithError)
+	assert.TypeMismatch("wrong type", should.PanicWithError, inner)
+	assert.TypeMismatch(panicky, should.PanicWithError, "inner")
+
+	assert.Pass(func() { panic(outer) }, should.PanicWithError, inner)
+	assert.Fail(func() { panic(inner) }, should.PanicWithError, outer)
+	assert.Fail(func() { panic(fmt.Sprint(inner)) }, should.PanicWithError, inner)
+	assert.Fail(func() {}, should.PanicWithError, inner)
+}
+
+func TestShouldPanicMatching(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(panicky, should.PanicMatching)
+	assert.TypeMismatch("wrong type", should.PanicMatching, "boink")
+	assert.TypeMismatch(panicky, should.PanicMatching, 42)
+	assert.InvalidPattern(panicky, should.PanicMatching, "(")
+
+	assert.Pass(panicky, should.PanicMatching, "^bo+ink$")
+	assert.Pass(func() { panic(errors.New("oh no")) }, should.PanicMatching, regexp.MustCompile("no"))
+	assert.Fail(panicky, should.PanicMatching, "bonk")
+	assert.Fail(func() {}, should.PanicMatching, "boink")
+}
+
+func panicky() { panic("boink") }
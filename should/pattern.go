@@ -0,0 +1,94 @@
+package should
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"regexp/syntax"
+)
+
+// compilePattern accepts either a regular expression
+// pattern string or an already compiled *regexp.Regexp.
+func compilePattern(v interface{}) (*regexp.Regexp, error) {
+	switch pattern := v.(type) {
+	case *regexp.Regexp:
+		if pattern != nil {
+			return pattern, nil
+		}
+	case string:
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, wrap(ErrInvalidPattern, "%s", err)
+		}
+		return compiled, nil
+	}
+	return nil, wrap(ErrTypeMismatch, "got %v, want string or *regexp.Regexp", reflect.TypeOf(v))
+}
+
+// textOf extracts the text to be matched from a string,
+// []byte, or fmt.Stringer value.
+func textOf(v interface{}) (string, error) {
+	switch text := v.(type) {
+	case string:
+		return text, nil
+	case []byte:
+		return string(text), nil
+	case fmt.Stringer:
+		return text.String(), nil
+	}
+	if v != nil && reflect.TypeOf(v).Kind() == reflect.String {
+		return reflect.ValueOf(v).String(), nil
+	}
+	return "", wrap(ErrTypeMismatch, "got %v, want string, []byte, or fmt.Stringer", reflect.TypeOf(v))
+}
+
+// closestPartialMatch describes how much of the pattern could be matched
+// against the text, to help pinpoint where a failed match went astray.
+// It finds the longest leading portion of the (top-level) sequence of
+// the pattern that matches somewhere in the text.
+func closestPartialMatch(pattern *regexp.Regexp, text string) string {
+	parsed, err := syntax.Parse(pattern.String(), syntax.Perl)
+	if err != nil {
+		return "(no partial match)"
+	}
+
+	elements := sequenceOf(parsed)
+	for k := len(elements) - 1; k > 0; k-- {
+		partial := &syntax.Regexp{Op: syntax.OpConcat, Sub: elements[:k]}
+		compiled, err := regexp.Compile(partial.String())
+		if err != nil {
+			continue
+		}
+		location := compiled.FindStringIndex(text)
+		if location == nil {
+			continue
+		}
+		remainder := &syntax.Regexp{Op: syntax.OpConcat, Sub: elements[k:]}
+		return fmt.Sprintf(""+
+			"closest partial match: %s matched %q at offset %d,\n"+
+			"  but the rest of the pattern (%s) failed to match at offset %d: %q",
+			compiled, text[location[0]:location[1]], location[0],
+			remainder, location[1], text[location[1]:],
+		)
+	}
+	return "(no partial match)"
+}
+
+// sequenceOf breaks a regular expression into the sequence of elements
+// that must match one after another, splitting literals into single runes.
+func sequenceOf(re *syntax.Regexp) (elements []*syntax.Regexp) {
+	subs := []*syntax.Regexp{re}
+	if re.Op == syntax.OpConcat {
+		subs = re.Sub
+	}
+	for _, sub := range subs {
+		if sub.Op != syntax.OpLiteral {
+			elements = append(elements, sub)
+			continue
+		}
+		for _, r := range sub.Rune {
+			elements = append(elements, &syntax.Regexp{Op: syntax.OpLiteral, Flags: sub.Flags, Rune: []rune{r}})
+		}
+	}
+	return elements
+}
@@ -0,0 +1,277 @@
+package should
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+	"unsafe"
+)
+
+// Printer renders values as Go-like literals for use in failure messages.
+// Unlike the %#v verb of package fmt, a Printer dereferences pointers
+// (marking any cycles), sorts map keys, elides the elements of large
+// collections, and spreads large composite values across several lines.
+type Printer struct {
+	// Indent is repeated once per level of nesting
+	// within composite values spread across lines.
+	Indent string
+
+	// MaxElements limits the number of elements shown (per array,
+	// slice, or map). The rest are elided. Zero means no limit.
+	MaxElements int
+
+	// MaxWidth is the width beyond which composite values are spread
+	// across several lines. Zero means values are never spread.
+	MaxWidth int
+}
+
+// DefaultPrinter is the Printer used by Format (and by the
+// failure messages of all the assertions in this package).
+var DefaultPrinter = Printer{Indent: "  ", MaxElements: 32, MaxWidth: 80}
+
+// Format renders the value via the DefaultPrinter.
+func Format(v interface{}) string {
+	return DefaultPrinter.Format(v)
+}
+
+// Format renders the value as a Go-like literal.
+func (this Printer) Format(v interface{}) string {
+	value := reflect.ValueOf(v)
+	if value.IsValid() {
+		// An addressable copy allows unexported fields to be inspected.
+		root := reflect.New(value.Type()).Elem()
+		root.Set(value)
+		value = root
+	}
+	return this.format(value)
+}
+
+func (this Printer) format(value reflect.Value) string {
+	printer := &printing{Printer: this, visiting: make(map[visit]bool)}
+	return printer.print(value, 0)
+}
+
+// formatLine renders the value (via the DefaultPrinter) on a single line.
+func formatLine(value reflect.Value) string {
+	printer := DefaultPrinter
+	printer.MaxWidth = 0
+	return printer.format(value)
+}
+
+// printing is the state of a Printer in the midst of rendering a value.
+type printing struct {
+	Printer
+	visiting map[visit]bool // the pointer-like values enclosing the current value
+}
+
+func (this *printing) print(value reflect.Value, depth int) string {
+	return this.printElement(value, nil, depth)
+}
+
+// printElement renders an element of an array, slice, or map (whose
+// elements are of the provided type) omitting the type of composite
+// literals where it is implied, as in []T{{...}, {...}}.
+func (this *printing) printElement(value reflect.Value, implied reflect.Type, depth int) string {
+	if !value.IsValid() {
+		return "nil"
+	}
+
+	if value.Type() == timeType {
+		return this.printTime(value)
+	}
+
+	switch value.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return fmt.Sprintf("%v", value)
+
+	case reflect.String:
+		return fmt.Sprintf("%q", value.String())
+
+	case reflect.Interface:
+		if value.IsNil() {
+			return "nil"
+		}
+		return this.print(value.Elem(), depth)
+
+	case reflect.Ptr:
+		if value.IsNil() {
+			return fmt.Sprintf("(%v)(nil)", value.Type())
+		}
+		if this.entering(value) {
+			return fmt.Sprintf("<cycle: %v>", value.Type())
+		}
+		defer this.leaving(value)
+		return "&" + this.print(value.Elem(), depth)
+
+	case reflect.Struct:
+		elements := make([]string, value.NumField())
+		for x := range elements {
+			elements[x] = value.Type().Field(x).Name + ": " + this.print(value.Field(x), depth+1)
+		}
+		return this.composite(this.typeName(value, implied), elements, 0, depth)
+
+	case reflect.Map:
+		if value.IsNil() {
+			return fmt.Sprintf("%v(nil)", value.Type())
+		}
+		if this.entering(value) {
+			return fmt.Sprintf("<cycle: %v>", value.Type())
+		}
+		defer this.leaving(value)
+		return this.printMap(value, this.typeName(value, implied), depth)
+
+	case reflect.Slice:
+		if value.IsNil() {
+			return fmt.Sprintf("%v(nil)", value.Type())
+		}
+		if this.entering(value) {
+			return fmt.Sprintf("<cycle: %v>", value.Type())
+		}
+		defer this.leaving(value)
+		return this.printSequence(value, this.typeName(value, implied), depth)
+
+	case reflect.Array:
+		return this.printSequence(value, this.typeName(value, implied), depth)
+
+	case reflect.Chan:
+		if value.IsNil() {
+			return fmt.Sprintf("(%v)(nil)", value.Type())
+		}
+		return fmt.Sprintf("(%v)(len %d, cap %d)", value.Type(), value.Len(), value.Cap())
+
+	case reflect.Func:
+		if value.IsNil() {
+			return fmt.Sprintf("(%v)(nil)", value.Type())
+		}
+		return fmt.Sprintf("(%v)(%s)", value.Type(), funcName(value))
+
+	default:
+		return fmt.Sprintf("(%v)(%#x)", value.Type(), value.Pointer())
+	}
+}
+
+func (this *printing) printMap(value reflect.Value, typ string, depth int) string {
+	type entry struct{ key, value string }
+	entries := make([]entry, 0, value.Len())
+	iterator := value.MapRange()
+	for iterator.Next() {
+		entries = append(entries, entry{
+			key:   this.printElement(iterator.Key(), value.Type().Key(), depth+1),
+			value: this.printElement(iterator.Value(), value.Type().Elem(), depth+1),
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+
+	shown := this.shown(len(entries))
+	elements := make([]string, shown)
+	for x := range elements {
+		elements[x] = entries[x].key + ": " + entries[x].value
+	}
+	return this.composite(typ, elements, len(entries)-shown, depth)
+}
+
+func (this *printing) printSequence(value reflect.Value, typ string, depth int) string {
+	shown := this.shown(value.Len())
+	elements := make([]string, shown)
+	for x := range elements {
+		elements[x] = this.printElement(value.Index(x), value.Type().Elem(), depth+1)
+	}
+	return this.composite(typ, elements, value.Len()-shown, depth)
+}
+
+// typeName names the type of the composite value, unless it is implied.
+func (this *printing) typeName(value reflect.Value, implied reflect.Type) string {
+	if value.Type() == implied {
+		return ""
+	}
+	return value.Type().String()
+}
+
+// printTime renders time.Time values (even those found within unexported
+// struct fields) via their String method.
+func (this *printing) printTime(value reflect.Value) string {
+	value = accessible(value)
+	if !value.CanInterface() {
+		return fmt.Sprintf("%v", value)
+	}
+	return "time.Time(" + value.Interface().(time.Time).String() + ")"
+}
+
+// accessible provides an equivalent of the value (which may have been
+// obtained via unexported struct fields) that can be interfaced, where
+// the value is addressable (and otherwise the value itself).
+func accessible(value reflect.Value) reflect.Value {
+	if !value.CanInterface() && value.CanAddr() {
+		return reflect.NewAt(value.Type(), unsafe.Pointer(value.UnsafeAddr())).Elem()
+	}
+	return value
+}
+
+// composite renders the elements of a composite value (along with
+// any elided elements) within braces, on one or several lines.
+func (this *printing) composite(typ string, elements []string, elided, depth int) string {
+	if elided > 0 {
+		elements = append(elements, fmt.Sprintf("... (%d more)", elided))
+	}
+	line := typ + "{" + strings.Join(elements, ", ") + "}"
+	if len(elements) == 0 || !this.spread(line, depth) {
+		return line
+	}
+
+	inner := strings.Repeat(this.Indent, depth+1)
+	builder := new(strings.Builder)
+	builder.WriteString(typ + "{\n")
+	for _, element := range elements {
+		builder.WriteString(inner + element + ",\n")
+	}
+	builder.WriteString(strings.Repeat(this.Indent, depth) + "}")
+	return builder.String()
+}
+
+// spread reports whether the (single-line) rendering of
+// a composite value should be spread across several lines.
+func (this *printing) spread(line string, depth int) bool {
+	if this.MaxWidth <= 0 {
+		return false
+	}
+	return strings.Contains(line, "\n") || len(this.Indent)*depth+len(line) > this.MaxWidth
+}
+
+func (this *printing) shown(length int) int {
+	if this.MaxElements > 0 && length > this.MaxElements {
+		return this.MaxElements
+	}
+	return length
+}
+
+// entering marks the (pointer-like) value as enclosing those about to
+// be rendered, reporting whether it already did (indicating a cycle).
+func (this *printing) entering(value reflect.Value) bool {
+	key := visit{actual: value.Pointer(), typ: value.Type()}
+	if this.visiting[key] {
+		return true
+	}
+	this.visiting[key] = true
+	return false
+}
+
+func (this *printing) leaving(value reflect.Value) {
+	delete(this.visiting, visit{actual: value.Pointer(), typ: value.Type()})
+}
+
+func funcName(value reflect.Value) string {
+	f := runtime.FuncForPC(value.Pointer())
+	if f == nil {
+		return "func"
+	}
+	name := f.Name()
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+var timeType = reflect.TypeOf(time.Time{})
@@ -0,0 +1,123 @@
+package should_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestFormatScalars(t *testing.T) {
+	assertFormat(t, nil, "nil")
+	assertFormat(t, true, "true")
+	assertFormat(t, 42, "42")
+	assertFormat(t, uint8(7), "7")
+	assertFormat(t, 1.5, "1.5")
+	assertFormat(t, "hi\n", `"hi\n"`)
+	assertFormat(t, time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC), "time.Time(2026-10-18 12:00:00 +0000 UTC)")
+}
+
+func TestFormatNils(t *testing.T) {
+	assertFormat(t, (*Owner)(nil), "(*should_test.Owner)(nil)")
+	assertFormat(t, []int(nil), "[]int(nil)")
+	assertFormat(t, map[string]int(nil), "map[string]int(nil)")
+	assertFormat(t, (chan int)(nil), "(chan int)(nil)")
+	assertFormat(t, (func())(nil), "(func())(nil)")
+	assertFormat(t, struct{ Err error }{}, "struct { Err error }{Err: nil}")
+}
+
+func TestFormatDereferencesPointers(t *testing.T) {
+	assertFormat(t, &Owner{Name: "Alice"}, `&should_test.Owner{Name: "Alice"}`)
+	assertFormat(t, []*Owner{{Name: "Alice"}}, `[]*should_test.Owner{&should_test.Owner{Name: "Alice"}}`)
+	assertFormat(t, errors.New("boom"), `&errors.errorString{s: "boom"}`)
+}
+
+func TestFormatMarksCycles(t *testing.T) {
+	type Node struct {
+		Value int
+		Next  *Node
+	}
+	a := &Node{Value: 1}
+	a.Next = &Node{Value: 2, Next: a}
+
+	assertFormat(t, a, ""+
+		"&should_test.Node{\n"+
+		"  Value: 1,\n"+
+		"  Next: &should_test.Node{Value: 2, Next: <cycle: *should_test.Node>},\n"+
+		"}")
+}
+
+func TestFormatRepeatsSharedPointers(t *testing.T) {
+	owner := &Owner{Name: "Alice"}
+
+	assertFormat(t, [2]*Owner{owner, owner}, ""+
+		"[2]*should_test.Owner{\n"+
+		"  &should_test.Owner{Name: \"Alice\"},\n"+
+		"  &should_test.Owner{Name: \"Alice\"},\n"+
+		"}")
+}
+
+func TestFormatSortsMapKeys(t *testing.T) {
+	assertFormat(t, map[string]int{"c": 3, "a": 1, "b": 2}, `map[string]int{"a": 1, "b": 2, "c": 3}`)
+}
+
+func TestFormatElidesImpliedElementTypes(t *testing.T) {
+	assertFormat(t, []Owner{{Name: "A"}}, `[]should_test.Owner{{Name: "A"}}`)
+	assertFormat(t, map[string][]int{"a": {1}}, `map[string][]int{"a": {1}}`)
+	assertFormat(t, []interface{}{Owner{}}, `[]interface {}{should_test.Owner{Name: ""}}`)
+}
+
+func TestFormatElidesElementsOfLargeCollections(t *testing.T) {
+	printer := should.Printer{MaxElements: 3}
+
+	actual := printer.Format([]int{1, 2, 3, 4, 5})
+
+	if actual != "[]int{1, 2, 3, ... (2 more)}" {
+		t.Errorf("got %s", actual)
+	}
+}
+
+func TestFormatSpreadsWideValuesAcrossLines(t *testing.T) {
+	printer := should.Printer{Indent: "\t", MaxWidth: 30}
+
+	actual := printer.Format(Account{ID: "acct-1", Name: "Savings", Tags: []string{"a"}})
+
+	expected := strings.Join([]string{
+		"should_test.Account{",
+		`	ID: "acct-1",`,
+		`	Name: "Savings",`,
+		"	Balance: 0,",
+		"	Owner: (*should_test.Owner)(nil),",
+		`	Tags: []string{"a"},`,
+		"	hidden: 0,",
+		"}",
+	}, "\n")
+	if actual != expected {
+		t.Errorf("got:\n%s\nwant:\n%s", actual, expected)
+	}
+}
+
+func TestFormatRendersChannelsAndFuncsDeterministically(t *testing.T) {
+	assertFormat(t, make(chan int, 2), "(chan int)(len 0, cap 2)")
+	assertFormat(t, TestFormatScalars, "(func(*testing.T))(should_test.TestFormatScalars)")
+}
+
+func TestFormatRendersTimesWithinUnexportedFields(t *testing.T) {
+	type event struct{ at time.Time }
+
+	assertFormat(t, event{at: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)}, "should_test.event{at: time.Time(2026-01-02 00:00:00 +0000 UTC)}")
+}
+
+func TestFailureMessagesUseFormat(t *testing.T) {
+	assertReportContains(t, should.BeNil(&Owner{Name: "Alice"}), `got &should_test.Owner{Name: "Alice"}, want <nil>`)
+	assertReportContains(t, should.Contain(map[int]string{2: "b", 1: "a"}, 3), `within:      map[int]string{1: "a", 2: "b"}`)
+}
+
+func assertFormat(t *testing.T, value interface{}, expected string) {
+	t.Helper()
+	if actual := should.Format(value); actual != expected {
+		t.Errorf("\n got: %s\nwant: %s", actual, expected)
+	}
+}
@@ -0,0 +1,195 @@
+package should
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Receive verifies that a value is immediately available from the channel
+// provided as actual (without blocking). If expected[0] is provided, the
+// received value must be Equal to it or, if it is an Assertion (see Bind),
+// must satisfy it. The value received is consumed from the channel.
+func Receive(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpectedRange(0, 1, expected)
+	if err != nil {
+		return err
+	}
+
+	return receive(actual, 0, expected)
+}
+
+// ReceiveWithin verifies that a value is received from the channel
+// provided as actual within the time.Duration provided as expected[0].
+// If expected[1] is provided, the received value must be Equal to it or,
+// if it is an Assertion (see Bind), must satisfy it. The value received
+// is consumed from the channel.
+func ReceiveWithin(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpectedRange(1, 2, expected)
+	if err != nil {
+		return err
+	}
+
+	err = validateType(expected[0], time.Duration(0))
+	if err != nil {
+		return err
+	}
+
+	return receive(actual, expected[0].(time.Duration), expected[1:])
+}
+
+// NotReceive verifies that no value is received from the channel provided
+// as actual, either immediately or within the (optional) time.Duration
+// provided as expected[0]. The closing of the channel does not count as
+// receiving a value. Any value received is consumed from the channel.
+func NotReceive(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpectedRange(0, 1, expected)
+	if err != nil {
+		return err
+	}
+
+	window := time.Duration(0)
+	if len(expected) > 0 {
+		err = validateType(expected[0], window)
+		if err != nil {
+			return err
+		}
+		window = expected[0].(time.Duration)
+	}
+
+	channel, err := validateReceiveChannel(actual)
+	if err != nil {
+		return err
+	}
+
+	result := receiveFrom(channel, window)
+	if !result.received || result.closed {
+		return nil
+	}
+
+	return failure("\n"+
+		"  received: %s\n"+
+		"  from:     %s\n"+
+		"  within:   %v",
+		Format(result.value.Interface()),
+		describeChannel(channel),
+		window,
+	)
+}
+
+// BeClosed verifies that the channel provided as actual is closed and
+// drained (that is, it has no buffered values left to be received).
+// Any value received from an open or undrained channel is consumed.
+func BeClosed(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(0, expected)
+	if err != nil {
+		return err
+	}
+
+	channel, err := validateReceiveChannel(actual)
+	if err != nil {
+		return err
+	}
+
+	result := receiveFrom(channel, 0)
+	if result.closed {
+		return nil
+	}
+
+	if result.received {
+		return failure("\n"+
+			"  channel not drained: %s\n"+
+			"  received:            %s",
+			describeChannel(channel),
+			Format(result.value.Interface()),
+		)
+	}
+
+	return failure("channel still open: %s", describeChannel(channel))
+}
+
+func receive(actual interface{}, timeout time.Duration, expected []interface{}) error {
+	channel, err := validateReceiveChannel(actual)
+	if err != nil {
+		return err
+	}
+
+	result := receiveFrom(channel, timeout)
+	if result.closed {
+		return failure("channel closed (without a value to receive): %s", describeChannel(channel))
+	}
+
+	if !result.received && timeout == 0 {
+		return failure("nothing ready to receive from: %s", describeChannel(channel))
+	}
+
+	if !result.received {
+		return failure("nothing received within %v from: %s", timeout, describeChannel(channel))
+	}
+
+	if len(expected) == 0 {
+		return nil
+	}
+
+	value := result.value.Interface()
+	if assertion, ok := asAssertion(expected[0]); ok {
+		err = assertion(value)
+	} else {
+		err = Equal(value, expected[0])
+	}
+	if errors.Is(err, ErrAssertionFailure) {
+		return failure("\n%s", describeFailure("received value", err))
+	}
+	return err
+}
+
+func validateReceiveChannel(actual interface{}) (reflect.Value, error) {
+	err := validateKind(actual, reflect.Chan)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	channel := reflect.ValueOf(actual)
+	if channel.Type().ChanDir()&reflect.RecvDir == 0 {
+		return reflect.Value{}, wrap(ErrTypeMismatch, "got %v, want a channel that can receive", channel.Type())
+	}
+	if channel.IsNil() {
+		return reflect.Value{}, wrap(ErrTypeMismatch, "got nil %v, want a non-nil channel", channel.Type())
+	}
+	return channel, nil
+}
+
+// received describes the outcome of an attempt to receive from a channel.
+type received struct {
+	value    reflect.Value // the value received (if any)
+	received bool          // whether a value (or the closing of the channel) was received
+	closed   bool          // whether the channel was closed (and drained)
+}
+
+// receiveFrom attempts to receive from the channel, waiting (at most)
+// as long as the timeout. A zero timeout results in a non-blocking attempt.
+func receiveFrom(channel reflect.Value, timeout time.Duration) (result received) {
+	cases := []reflect.SelectCase{{Dir: reflect.SelectRecv, Chan: channel}}
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)})
+	} else {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectDefault})
+	}
+
+	chosen, value, ok := reflect.Select(cases)
+	if chosen != 0 {
+		return result
+	}
+	return received{value: value, received: true, closed: !ok}
+}
+
+func describeChannel(channel reflect.Value) string {
+	return fmt.Sprintf("(%v) len %d, cap %d", channel.Type(), channel.Len(), channel.Cap())
+}
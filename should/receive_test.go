@@ -0,0 +1,115 @@
+package should_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+// buffered returns a channel holding the values, closed if requested.
+func buffered(closed bool, values ...int) chan int {
+	channel := make(chan int, len(values)+1)
+	for _, value := range values {
+		channel <- value
+	}
+	if closed {
+		close(channel)
+	}
+	return channel
+}
+
+// eventually returns a channel on which the value is sent after the delay.
+func eventually(delay time.Duration, value string) <-chan string {
+	channel := make(chan string, 1)
+	go func() {
+		time.Sleep(delay)
+		channel <- value
+	}()
+	return channel
+}
+
+func TestShouldReceive(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(buffered(false, 1), should.Receive, 1, 2)
+	assert.KindMismatch([]int{1}, should.Receive)
+	assert.TypeMismatch(make(chan<- int, 1), should.Receive)
+	assert.TypeMismatch((chan int)(nil), should.Receive)
+
+	assert.Pass(buffered(false, 1), should.Receive)
+	assert.Pass(buffered(false, 1), should.Receive, 1)
+	assert.Pass(buffered(false, 1), should.Receive, int64(1))
+	assert.Pass(buffered(false, 1), should.Receive, should.Bind(should.BeGreaterThan, 0))
+	assert.Fail(buffered(false, 1), should.Receive, 2)
+	assert.Fail(buffered(false, 1), should.Receive, should.Bind(should.BeLessThan, 0))
+	assert.Fail(buffered(false), should.Receive)
+	assert.Fail(buffered(true), should.Receive)
+	assert.Pass(buffered(true, 1), should.Receive, 1)
+	assert.Fail(eventually(time.Millisecond*50, "hi"), should.Receive)
+}
+
+func TestShouldReceiveConsumesValues(t *testing.T) {
+	channel := buffered(false, 1, 2)
+
+	if err := should.Receive(channel, 1); err != nil {
+		t.Error(err)
+	}
+	if err := should.Receive(channel, 2); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestShouldReceiveReportsReceivedValue(t *testing.T) {
+	err := should.Receive(buffered(false, 1), 2)
+
+	assertReportContains(t, err, "received value:", "Expected: (int) 2")
+}
+
+func TestShouldReceiveWithin(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(buffered(false, 1), should.ReceiveWithin)
+	assert.ExpectedCountInvalid(buffered(false, 1), should.ReceiveWithin, time.Second, 1, 2)
+	assert.TypeMismatch(buffered(false, 1), should.ReceiveWithin, 1)
+	assert.KindMismatch(1, should.ReceiveWithin, time.Second)
+
+	assert.Pass(buffered(false, 1), should.ReceiveWithin, time.Millisecond)
+	assert.Pass(eventually(time.Millisecond, "hi"), should.ReceiveWithin, time.Second, "hi")
+	assert.Fail(eventually(time.Millisecond, "hi"), should.ReceiveWithin, time.Second, "bye")
+	assert.Fail(eventually(time.Second, "hi"), should.ReceiveWithin, time.Millisecond)
+	assert.Fail(buffered(true), should.ReceiveWithin, time.Second)
+}
+
+func TestShouldNotReceive(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(buffered(false), should.NotReceive, time.Second, 1)
+	assert.TypeMismatch(buffered(false), should.NotReceive, 1)
+	assert.KindMismatch(1, should.NotReceive)
+
+	assert.Pass(buffered(false), should.NotReceive)
+	assert.Pass(buffered(true), should.NotReceive)
+	assert.Fail(buffered(false, 1), should.NotReceive)
+	assert.Pass(buffered(false), should.NotReceive, time.Millisecond)
+	assert.Pass(eventually(time.Second, "hi"), should.NotReceive, time.Millisecond)
+	assert.Fail(eventually(time.Millisecond, "hi"), should.NotReceive, time.Second)
+}
+
+func TestShouldBeClosed(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(buffered(true), should.BeClosed, 1)
+	assert.KindMismatch(1, should.BeClosed)
+
+	assert.Pass(buffered(true), should.BeClosed)
+	assert.Fail(buffered(false), should.BeClosed)
+	assert.Fail(buffered(true, 1), should.BeClosed)
+	assert.Fail(make(chan struct{}), should.BeClosed)
+}
+
+func TestShouldBeClosedReportsUndrainedValue(t *testing.T) {
+	err := should.BeClosed(buffered(true, 42))
+
+	assertReportContains(t, err, "channel not drained: (chan int) len 0, cap 2", "received:            42")
+}
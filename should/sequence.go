@@ -0,0 +1,177 @@
+package should
+
+import (
+	"errors"
+	"reflect"
+)
+
+// ContainSequence verifies that the array or slice provided as actual
+// contains the elements of the array or slice provided as expected[0],
+// contiguously and in the same order (elements are compared with Equal).
+func ContainSequence(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	actualValue, sequence, err := validateSequences(actual, expected)
+	if err != nil {
+		return err
+	}
+
+	if indexOfSequence(actualValue, sequence) >= 0 {
+		return nil
+	}
+
+	return failure("\n"+
+		"   sequence absent: %s\n"+
+		"   within:          %s",
+		Format(expected[0]),
+		Format(actual),
+	)
+}
+
+// ContainSequence (negated!)
+func (negated) ContainSequence(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = ContainSequence(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("\n"+
+		"   sequence found: %s\n"+
+		"   at index:       %d\n"+
+		"   within:         %s",
+		Format(expected[0]),
+		indexOfSequence(reflect.ValueOf(actual), reflect.ValueOf(expected[0])),
+		Format(actual),
+	)
+}
+
+// ContainInOrder verifies that the array or slice provided as actual
+// contains the elements of the array or slice provided as expected[0]
+// in the same order, though not necessarily contiguously (elements are
+// compared with Equal).
+func ContainInOrder(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	actualValue, sequence, err := validateSequences(actual, expected)
+	if err != nil {
+		return err
+	}
+
+	matched := matchInOrder(actualValue, sequence)
+	if matched == sequence.Len() {
+		return nil
+	}
+
+	return failure("\n"+
+		"   matched in order: %s\n"+
+		"   then absent:      %s\n"+
+		"   within:           %s",
+		Format(sequence.Slice(0, matched).Interface()),
+		Format(sequence.Index(matched).Interface()),
+		Format(actual),
+	)
+}
+
+// ContainInOrder (negated!)
+func (negated) ContainInOrder(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = ContainInOrder(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("\n"+
+		"   found in order: %s\n"+
+		"   within:         %s",
+		Format(expected[0]),
+		Format(actual),
+	)
+}
+
+func validateSequences(actual interface{}, expected []interface{}) (actualValue, sequence reflect.Value, err error) {
+	err = validateExpected(1, expected)
+	if err != nil {
+		return actualValue, sequence, err
+	}
+
+	err = validateKind(actual, reflect.Array, reflect.Slice)
+	if err != nil {
+		return actualValue, sequence, err
+	}
+
+	err = validateKind(expected[0], reflect.Array, reflect.Slice)
+	if err != nil {
+		return actualValue, sequence, err
+	}
+
+	return reflect.ValueOf(actual), sliceable(reflect.ValueOf(expected[0])), nil
+}
+
+// isSequenceOf reports whether the expected value should be treated as a
+// sequence of elements of the (array or slice) actual value, rather than
+// as a single element. That is the case when expected is itself an array
+// or slice, unless the elements of actual are arrays or slices too.
+func isSequenceOf(actual reflect.Value, expected interface{}) bool {
+	if expected == nil || !isSequenceKind(reflect.TypeOf(expected).Kind()) {
+		return false
+	}
+	return !isSequenceKind(actual.Type().Elem().Kind())
+}
+
+func isSequenceKind(kind reflect.Kind) bool {
+	return kind == reflect.Array || kind == reflect.Slice
+}
+
+// sequenceAt reports whether the sequence appears
+// within the actual value, starting at the offset.
+func sequenceAt(actual, sequence reflect.Value, offset int) bool {
+	if offset < 0 || offset+sequence.Len() > actual.Len() {
+		return false
+	}
+	for x := 0; x < sequence.Len(); x++ {
+		if !equal(actual.Index(offset+x).Interface(), sequence.Index(x).Interface()) {
+			return false
+		}
+	}
+	return true
+}
+
+// indexOfSequence finds the first offset at which the sequence
+// appears within the actual value (or -1 if it doesn't).
+func indexOfSequence(actual, sequence reflect.Value) int {
+	for offset := 0; offset+sequence.Len() <= actual.Len(); offset++ {
+		if sequenceAt(actual, sequence, offset) {
+			return offset
+		}
+	}
+	return -1
+}
+
+// matchInOrder counts the leading elements of the sequence that
+// can be found, one after another, within the actual value.
+func matchInOrder(actual, sequence reflect.Value) (matched int) {
+	for x := 0; x < actual.Len() && matched < sequence.Len(); x++ {
+		if equal(actual.Index(x).Interface(), sequence.Index(matched).Interface()) {
+			matched++
+		}
+	}
+	return matched
+}
+
+// sliceable returns an addressable copy of (unaddressable)
+// arrays so that they, like slices, may be sliced.
+func sliceable(value reflect.Value) reflect.Value {
+	if value.Kind() != reflect.Array || value.CanAddr() {
+		return value
+	}
+	clone := reflect.New(value.Type()).Elem()
+	clone.Set(value)
+	return clone
+}
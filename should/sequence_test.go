@@ -0,0 +1,55 @@
+package should_test
+
+import (
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestShouldContainSequence(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid([]int{1}, should.ContainSequence)
+	assert.ExpectedCountInvalid([]int{1}, should.ContainSequence, []int{1}, "EXTRA")
+	assert.KindMismatch("abc", should.ContainSequence, []int{1})
+	assert.KindMismatch([]int{1}, should.ContainSequence, 1)
+
+	assert.Pass([]int{1, 2, 3, 4}, should.ContainSequence, []int{})
+	assert.Pass([]int{1, 2, 3, 4}, should.ContainSequence, []int{2, 3})
+	assert.Pass([]int{1, 2, 3, 4}, should.ContainSequence, [2]int64{3, 4})
+	assert.Pass([4]int{1, 2, 3, 4}, should.ContainSequence, []int{1, 2, 3, 4})
+	assert.Pass([]int{1, 2, 1, 2, 3}, should.ContainSequence, []int{1, 2, 3})
+	assert.Fail([]int{1, 2, 3, 4}, should.ContainSequence, []int{2, 4})
+	assert.Fail([]int{1, 2}, should.ContainSequence, []int{1, 2, 3})
+	assert.Fail([]int(nil), should.ContainSequence, []int{1})
+
+	assert.Fail([]int{1, 2, 3, 4}, should.NOT.ContainSequence, []int{2, 3})
+	assert.Pass([]int{1, 2, 3, 4}, should.NOT.ContainSequence, []int{2, 4})
+}
+
+func TestShouldContainInOrder(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid([]int{1}, should.ContainInOrder)
+	assert.KindMismatch("abc", should.ContainInOrder, []int{1})
+	assert.KindMismatch([]int{1}, should.ContainInOrder, 1)
+
+	assert.Pass([]int{1, 2, 3, 4}, should.ContainInOrder, []int{})
+	assert.Pass([]int{1, 2, 3, 4}, should.ContainInOrder, []int{2, 4})
+	assert.Pass([]int{1, 2, 3, 4}, should.ContainInOrder, [2]int{1, 4})
+	assert.Pass([]int{1, 2, 2}, should.ContainInOrder, []int{2, 2})
+	assert.Fail([]int{1, 2, 3, 4}, should.ContainInOrder, []int{4, 2})
+	assert.Fail([]int{1, 2}, should.ContainInOrder, []int{2, 2})
+
+	assert.Fail([]int{1, 2, 3, 4}, should.NOT.ContainInOrder, []int{1, 3})
+	assert.Pass([]int{1, 2, 3, 4}, should.NOT.ContainInOrder, []int{3, 1})
+}
+
+func TestShouldContainInOrderReportsFirstAbsentElement(t *testing.T) {
+	err := should.ContainInOrder([]string{"a", "b", "c"}, [3]string{"a", "c", "b"})
+
+	assertReportContains(t, err,
+		`matched in order: []string{"a", "c"}`,
+		`then absent:      "b"`,
+	)
+}
@@ -0,0 +1,181 @@
+package should
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// updateFlag, when set (as with the SHOULD_UPDATE environment variable
+// set to a non-empty value), causes MatchSnapshot and MatchGoldenFile to
+// (re)write their files with the actual value, rather than comparing the
+// actual value against them.
+const updateFlag = "should.update"
+
+func init() {
+	// Guarded, in case another copy of this package registered it first.
+	if flag.Lookup(updateFlag) == nil {
+		flag.Bool(updateFlag, false, "rewrite snapshots and golden files with actual values")
+	}
+}
+
+// SnapshotDirectory is the directory (relative to the package under
+// test) in which MatchSnapshot stores its snapshots.
+var SnapshotDirectory = filepath.Join("testdata", "snapshots")
+
+// MatchSnapshot verifies that the serialized actual value matches the
+// snapshot stored for the test provided as expected[0] (anything with a
+// Name() string method, such as *testing.T or suite.T). An optional
+// label (expected[1]) distinguishes several snapshots within one test.
+// Snapshots are stored under SnapshotDirectory. A missing snapshot is a
+// failure (so that a snapshot that was deleted, or never checked in, is
+// not silently recorded in CI) unless updating, in which case the
+// serialized actual value is recorded as the snapshot. Strings and
+// []byte are serialized as-is and other values as (indented) JSON,
+// falling back to a Printer (like Format, but eliding nothing). A
+// slash within the label is not treated as a subdirectory.
+func MatchSnapshot(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpectedRange(1, 2, expected)
+	if err != nil {
+		return err
+	}
+
+	test, ok := expected[0].(interface{ Name() string })
+	if !ok {
+		return wrap(ErrTypeMismatch, "got %T, want a test (with a Name() string method)", expected[0])
+	}
+
+	elements := strings.Split(test.Name(), "/")
+	if len(expected) > 1 {
+		label, ok := expected[1].(string)
+		if !ok {
+			return wrap(ErrTypeMismatch, "got %T, want a string label", expected[1])
+		}
+		elements = append(elements, label)
+	}
+
+	return matchFile(filepath.Join(SnapshotDirectory, snapshotFilename(elements)), actual)
+}
+
+// MatchGoldenFile verifies that the serialized actual value (see
+// MatchSnapshot) matches the contents of the file whose path is provided
+// as expected[0]. As with MatchSnapshot, a missing file is a failure
+// (unless updating, in which case the file is written).
+func MatchGoldenFile(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	err = validateType(expected[0], "")
+	if err != nil {
+		return err
+	}
+
+	return matchFile(expected[0].(string), actual)
+}
+
+func matchFile(path string, actual interface{}) error {
+	serialized := serialize(actual)
+	if updating() {
+		return writeSnapshot(path, serialized)
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return failure("no such file: %s (run with -should.update or SHOULD_UPDATE=1 to create it)", path)
+	}
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(content, serialized) {
+		return nil
+	}
+
+	diff := unifiedDiff(string(content), string(serialized))
+	return diffFailure(diff, "\n"+
+		"  mismatched file: %s\n"+
+		"  (run with -should.update or SHOULD_UPDATE=1 to accept the actual value)\n"+
+		"%s",
+		path,
+		diff,
+	)
+}
+
+func updating() bool {
+	if os.Getenv("SHOULD_UPDATE") != "" {
+		return true
+	}
+	updating, ok := flag.Lookup(updateFlag).Value.(flag.Getter)
+	return ok && updating.Get() == true
+}
+
+func writeSnapshot(path string, content []byte) error {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, content, 0644)
+}
+
+// serialize renders strings and []byte as-is and other values as
+// (indented) JSON, or via the snapshotPrinter if that fails.
+func serialize(v interface{}) []byte {
+	switch v := v.(type) {
+	case string:
+		return []byte(v)
+	case []byte:
+		return v
+	}
+	serialized, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		serialized = []byte(snapshotPrinter.Format(v))
+	}
+	return append(serialized, '\n')
+}
+
+// snapshotPrinter renders values (unlike the DefaultPrinter) without
+// eliding any elements, so that snapshots capture every detail.
+var snapshotPrinter = Printer{Indent: "  ", MaxWidth: 80}
+
+// snapshotFilename derives a (relative) file name from the elements of
+// the name of a test (and of its subtests, and any label), placing each
+// element after the first in a subdirectory. Unsafe characters (including
+// any slashes within an element) are replaced, in which case a hash of
+// the elements is appended so that distinct names never share a file.
+func snapshotFilename(elements []string) string {
+	safeElements := make([]string, len(elements))
+	sanitized := false
+	for x, element := range elements {
+		safe := strings.Map(func(r rune) rune {
+			switch {
+			case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9', r == '-', r == '.', r == '_':
+				return r
+			default:
+				return '_'
+			}
+		}, element)
+		if element == "" || element == "." || element == ".." {
+			safe = "_" + element
+		}
+		sanitized = sanitized || safe != element
+		safeElements[x] = safe
+	}
+
+	filename := filepath.Join(safeElements...)
+	if sanitized {
+		hash := sha256.Sum256([]byte(fmt.Sprintf("%q", elements)))
+		filename += "-" + hex.EncodeToString(hash[:6])
+	}
+	return filename + ".snap"
+}
@@ -0,0 +1,177 @@
+package should_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+type named string
+
+func (this named) Name() string { return string(this) }
+
+// inSnapshotDirectory stores snapshots in a temporary directory for the duration of the test.
+func inSnapshotDirectory(t *testing.T) string {
+	directory := should.SnapshotDirectory
+	should.SnapshotDirectory = t.TempDir()
+	t.Cleanup(func() { should.SnapshotDirectory = directory })
+	return should.SnapshotDirectory
+}
+
+// updating sets the SHOULD_UPDATE environment variable for the duration of the test.
+func updating(t *testing.T) {
+	_ = os.Setenv("SHOULD_UPDATE", "1")
+	t.Cleanup(func() { _ = os.Unsetenv("SHOULD_UPDATE") })
+}
+
+func TestShouldMatchSnapshot(t *testing.T) {
+	assert := NewAssertion(t)
+	directory := inSnapshotDirectory(t)
+
+	assert.ExpectedCountInvalid("a", should.MatchSnapshot)
+	assert.ExpectedCountInvalid("a", should.MatchSnapshot, t, "label", "EXTRA")
+	assert.TypeMismatch("a", should.MatchSnapshot, "TestName")
+	assert.TypeMismatch("a", should.MatchSnapshot, t, 1)
+
+	assert.Fail("first\nrun", should.MatchSnapshot, named("TestRecord")) // missing
+	updating(t)
+	assert.Pass("first\nrun", should.MatchSnapshot, named("TestRecord"))
+	assert.Pass(Owner{Name: "Alice"}, should.MatchSnapshot, t, "owner")
+	_ = os.Unsetenv("SHOULD_UPDATE")
+
+	assert.Pass("first\nrun", should.MatchSnapshot, named("TestRecord"))
+	assert.Fail("second\nrun", should.MatchSnapshot, named("TestRecord"))
+	assert.Pass(Owner{Name: "Alice"}, should.MatchSnapshot, t, "owner")
+	assert.Fail(Owner{Name: "Bob"}, should.MatchSnapshot, t, "owner")
+
+	content, err := ioutil.ReadFile(filepath.Join(directory, "TestShouldMatchSnapshot", "owner.snap"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "{\n  \"Name\": \"Alice\"\n}\n" {
+		t.Errorf("unexpected snapshot content: %q", content)
+	}
+}
+
+func TestShouldMatchSnapshotUpdates(t *testing.T) {
+	assert := NewAssertion(t)
+	inSnapshotDirectory(t)
+
+	updating(t)
+	assert.Pass("before", should.MatchSnapshot, named("TestUpdate"))
+	assert.Pass("after", should.MatchSnapshot, named("TestUpdate"))
+	_ = os.Unsetenv("SHOULD_UPDATE")
+	assert.Pass("after", should.MatchSnapshot, named("TestUpdate"))
+	assert.Fail("before", should.MatchSnapshot, named("TestUpdate"))
+}
+
+func TestShouldMatchSnapshotKeepsDistinctNamesApart(t *testing.T) {
+	assert := NewAssertion(t)
+	directory := inSnapshotDirectory(t)
+	names := []named{"a b", "a_b", "a/b", "é", "ü", "..", "../escape", "a//b"}
+
+	updating(t)
+	for _, name := range names {
+		assert.Pass(string(name), should.MatchSnapshot, name)
+	}
+	_ = os.Unsetenv("SHOULD_UPDATE")
+
+	for _, name := range names {
+		assert.Pass(string(name), should.MatchSnapshot, name)
+	}
+	if _, err := os.Stat(filepath.Join(directory, "a_b.snap")); err != nil {
+		t.Error("safe names should be used as-is:", err)
+	}
+	if _, err := os.Stat(filepath.Join(directory, "..", "escape.snap")); err == nil {
+		t.Error("snapshot written outside of the snapshot directory")
+	}
+}
+
+func TestShouldMatchSnapshotReportsUnifiedDiff(t *testing.T) {
+	inSnapshotDirectory(t)
+	updating(t)
+	_ = should.MatchSnapshot("a\nb\nc", t)
+	_ = os.Unsetenv("SHOULD_UPDATE")
+
+	err := should.MatchSnapshot("a\nB\nc", t)
+
+	assertReportContains(t, err,
+		"mismatched file: ",
+		"TestShouldMatchSnapshotReportsUnifiedDiff.snap",
+		"--- expected\n+++ actual",
+		"-    2      | b",
+		"+         2 | B",
+	)
+}
+
+func TestShouldMatchGoldenFile(t *testing.T) {
+	assert := NewAssertion(t)
+	golden := filepath.Join(t.TempDir(), "golden.txt")
+
+	assert.ExpectedCountInvalid("a", should.MatchGoldenFile)
+	assert.TypeMismatch("a", should.MatchGoldenFile, 1)
+
+	assert.Fail("content", should.MatchGoldenFile, golden)
+	if err := ioutil.WriteFile(golden, []byte("content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	assert.Pass("content", should.MatchGoldenFile, golden)
+	assert.Pass([]byte("content"), should.MatchGoldenFile, golden)
+	assert.Fail("other", should.MatchGoldenFile, golden)
+
+	updating(t)
+	nested := filepath.Join(t.TempDir(), "nested", "golden.json")
+	assert.Pass([]int{1, 2}, should.MatchGoldenFile, nested)
+	_ = os.Unsetenv("SHOULD_UPDATE")
+	assert.Pass([]int{1, 2}, should.MatchGoldenFile, nested)
+	assert.Fail([]int{1, 3}, should.MatchGoldenFile, nested)
+}
+
+func TestShouldMatchGoldenFileSerializesUnmarshalableValues(t *testing.T) {
+	golden := filepath.Join(t.TempDir(), "golden.txt")
+	updating(t)
+
+	err := should.MatchGoldenFile(make(chan int), golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, _ := ioutil.ReadFile(golden)
+	assertReportContains(t, errorString(content), "(chan int)")
+}
+
+type errorString string
+
+func (this errorString) Error() string { return string(this) }
+
+func TestShouldMatchSnapshotKeepsLabelsApartFromSubtests(t *testing.T) {
+	assert := NewAssertion(t)
+	inSnapshotDirectory(t)
+
+	updating(t)
+	assert.Pass("label", should.MatchSnapshot, named("TestX"), "a/b")
+	assert.Pass("subtest", should.MatchSnapshot, named("TestX/a"), "b")
+	_ = os.Unsetenv("SHOULD_UPDATE")
+
+	assert.Pass("label", should.MatchSnapshot, named("TestX"), "a/b")
+	assert.Pass("subtest", should.MatchSnapshot, named("TestX/a"), "b")
+}
+
+func TestShouldMatchSnapshotSerializesLargeValuesCompletely(t *testing.T) {
+	assert := NewAssertion(t)
+	inSnapshotDirectory(t)
+
+	before := make([]complex128, 100) // (which can't be serialized as JSON)
+	after := append([]complex128(nil), before...)
+	after[50] = 1i
+
+	updating(t)
+	assert.Pass(before, should.MatchSnapshot, named("TestLarge"))
+	_ = os.Unsetenv("SHOULD_UPDATE")
+
+	assert.Pass(before, should.MatchSnapshot, named("TestLarge"))
+	assert.Fail(after, should.MatchSnapshot, named("TestLarge"))
+}
@@ -0,0 +1,117 @@
+package should
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Specification is a rule for deciding whether two values are equal,
+// as consulted by Equal (and, therefore, by Contain, BeIn, StartWith,
+// EndWith and other assertions which compare values via Equal).
+//
+// For each comparison, the registered specifications are consulted (most
+// recently registered first) before the built-in rules. The first whose
+// IsSatisfiedBy method returns true decides the outcome via AreEqual.
+type Specification interface {
+	// IsSatisfiedBy reports whether this specification applies to the values.
+	IsSatisfiedBy(a, b interface{}) bool
+
+	// AreEqual reports whether the values are equal.
+	AreEqual(a, b interface{}) bool
+}
+
+// RegisterSpecification makes the provided Specification available to
+// Equal, taking precedence over the built-in (and previously registered)
+// specifications. It is safe to call concurrently with assertions, but
+// is typically called from an init function or a TestMain. The returned
+// func undoes the registration (as from t.Cleanup, for registrations
+// that should only apply to a single test).
+func RegisterSpecification(spec Specification) (unregister func()) {
+	registered := &registration{spec}
+	registry.Lock()
+	defer registry.Unlock()
+	registry.specs = append([]*registration{registered}, registry.specs...)
+	return func() {
+		registry.Lock()
+		defer registry.Unlock()
+		for x, candidate := range registry.specs {
+			if candidate == registered {
+				registry.specs = append(registry.specs[:x:x], registry.specs[x+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// registration distinguishes each call to RegisterSpecification
+// (as specifications need not be comparable, or distinct).
+type registration struct{ Specification }
+
+var registry struct {
+	sync.RWMutex
+	specs []*registration
+}
+
+// specifications lists the registered specifications
+// followed by the built-in specifications.
+func specifications() []Specification {
+	registry.RLock()
+	defer registry.RUnlock()
+	all := make([]Specification, 0, len(registry.specs)+len(builtinSpecs))
+	for _, registered := range registry.specs {
+		all = append(all, registered.Specification)
+	}
+	return append(all, builtinSpecs...)
+}
+
+// nestedSpecifications lists the specifications to be applied within
+// composite values as they are walked by the deepEquality specification.
+func nestedSpecifications() (nested []Specification) {
+	for _, spec := range specifications() {
+		if _, deep := spec.(deepEquality); !deep {
+			nested = append(nested, spec)
+		}
+	}
+	return nested
+}
+
+// equalMethodEquality compares values using the `Equal(T) bool` method
+// of the first value, where the second value is assignable to T, as for
+// values of types like time.Time, net.IP, and big.Float-like domain types.
+type equalMethodEquality struct{}
+
+func (this equalMethodEquality) IsSatisfiedBy(a, b interface{}) bool {
+	_, ok := equalMethod(a, b)
+	return ok
+}
+func (this equalMethodEquality) AreEqual(a, b interface{}) bool {
+	method, _ := equalMethod(a, b)
+	return method.Call([]reflect.Value{reflect.ValueOf(b)})[0].Bool()
+}
+
+// equalMethod finds the `Equal(T) bool` method of a, if
+// a is non-nil and b is a (non-nil) value assignable to T.
+func equalMethod(a, b interface{}) (reflect.Value, bool) {
+	if a == nil || b == nil {
+		return reflect.Value{}, false
+	}
+	value := reflect.ValueOf(a)
+	if value.Kind() == reflect.Ptr && value.IsNil() {
+		return reflect.Value{}, false
+	}
+	method := value.MethodByName("Equal")
+	if !method.IsValid() {
+		return reflect.Value{}, false
+	}
+	typ := method.Type()
+	if typ.NumIn() != 1 || typ.NumOut() != 1 || typ.Out(0).Kind() != reflect.Bool {
+		return reflect.Value{}, false
+	}
+	if !reflect.TypeOf(b).AssignableTo(typ.In(0)) {
+		return reflect.Value{}, false
+	}
+	if other := reflect.ValueOf(b); other.Kind() == reflect.Ptr && other.IsNil() {
+		return reflect.Value{}, false
+	}
+	return method, true
+}
@@ -0,0 +1,71 @@
+package should_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestShouldEqualUsesEqualMethods(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.Pass(Money{Cents: 100, Currency: "usd"}, should.Equal, Money{Cents: 100, Currency: "USD"})
+	assert.Fail(Money{Cents: 100, Currency: "usd"}, should.Equal, Money{Cents: 101, Currency: "USD"})
+	assert.Pass(net.ParseIP("127.0.0.1"), should.Equal, net.ParseIP("::ffff:127.0.0.1"))
+	assert.Fail((*Money)(nil), should.Equal, &Money{})
+	assert.Pass((*Money)(nil), should.Equal, (*Money)(nil))
+
+	assert.Pass([]Money{{Cents: 1, Currency: "usd"}}, should.Contain, Money{Cents: 1, Currency: "USD"})
+}
+
+func TestShouldEqualUsesRegisteredSpecifications(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.Fail(Caseless("Hello"), should.Equal, Caseless("HELLO"))
+
+	t.Cleanup(should.RegisterSpecification(caselessEquality{}))
+
+	assert.Pass(Caseless("Hello"), should.Equal, Caseless("HELLO"))
+	assert.Fail(Caseless("Hello"), should.Equal, Caseless("Goodbye"))
+	assert.Pass([]Caseless{"a", "B"}, should.Contain, Caseless("b"))
+	assert.Pass(Caseless("b"), should.BeIn, []Caseless{"a", "B"})
+	assert.Pass([]Caseless{"a", "B"}, should.StartWith, Caseless("A"))
+	assert.Pass([]Caseless{"a", "B"}, should.EndWith, Caseless("b"))
+}
+
+func TestRegisteredSpecificationsCanBeUnregistered(t *testing.T) {
+	assert := NewAssertion(t)
+
+	first := should.RegisterSpecification(caselessEquality{})
+	second := should.RegisterSpecification(caselessEquality{})
+	first()
+	assert.Pass(Caseless("Hello"), should.Equal, Caseless("HELLO"))
+
+	second()
+	second() // repeated calls have no further effect
+	assert.Fail(Caseless("Hello"), should.Equal, Caseless("HELLO"))
+}
+
+type Money struct {
+	Cents    int
+	Currency string
+}
+
+func (this Money) Equal(that Money) bool {
+	return this.Cents == that.Cents && strings.EqualFold(this.Currency, that.Currency)
+}
+
+type Caseless string
+
+type caselessEquality struct{}
+
+func (caselessEquality) IsSatisfiedBy(a, b interface{}) bool {
+	_, aOK := a.(Caseless)
+	_, bOK := b.(Caseless)
+	return aOK && bOK
+}
+func (caselessEquality) AreEqual(a, b interface{}) bool {
+	return strings.EqualFold(string(a.(Caseless)), string(b.(Caseless)))
+}
@@ -0,0 +1,179 @@
+package should
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// StackFilter selects the frames of the call stack which are shown in
+// failure reports (the first of which is reported as the location of
+// the failed assertion). Frames of this package (and of its siblings,
+// assert and suite) and of functions marked via Helper are always
+// omitted (functions marked only via testing.T.Helper are not). Of the
+// remaining frames, those matching any exclusion are omitted and, when
+// any inclusions are given, only those matching an inclusion are shown.
+type StackFilter struct {
+	IncludePackages []string // import path prefixes of packages whose frames are shown
+	ExcludePackages []string // import path prefixes of packages whose frames are omitted
+	IncludeFiles    []string // patterns (see path.Match) of files whose frames are shown
+	ExcludeFiles    []string // patterns (see path.Match) of files whose frames are omitted
+	MaxFrames       int      // the number of frames shown (0 for no limit)
+}
+
+// DefaultStackFilter is the StackFilter applied to failure reports. File
+// patterns are matched against the base name of a file (as in "*_test.go")
+// unless they contain a slash, in which case they are matched against the
+// full path of the file (as in "/home/*/project/internal/*").
+var DefaultStackFilter = StackFilter{
+	ExcludePackages: []string{"runtime", "testing", "reflect"},
+	MaxFrames:       16,
+}
+
+// Helper marks the calling function as a test helper, such that its
+// frames are omitted from the stacks of failure reports and the location
+// of a failure is reported as the place from which the helper was called.
+//
+// Calls to testing.T.Helper are NOT observed (the testing package keeps
+// its helpers to itself): they only affect the "file:line:" prefix that
+// testing adds to logged messages. So a helper that makes assertions
+// should call both t.Helper() and should.Helper(). Unlike t.Helper(),
+// which applies to a single test, should.Helper() marks the function for
+// the remainder of the process (being a property of the function, rather
+// than of any test), so there is nothing to undo.
+func Helper() {
+	pcs := make([]uintptr, 1)
+	if runtime.Callers(2, pcs) == 0 {
+		return
+	}
+	frame, _ := runtime.CallersFrames(pcs).Next()
+	helpers.Lock()
+	defer helpers.Unlock()
+	helpers.functions[frame.Function] = true
+}
+
+var helpers = struct {
+	sync.RWMutex
+	functions map[string]bool
+}{functions: make(map[string]bool)}
+
+func isHelper(function string) bool {
+	helpers.RLock()
+	defer helpers.RUnlock()
+	return helpers.functions[function]
+}
+
+// filter gathers the frames which are shown, in the order encountered.
+func (this StackFilter) filter(frames *runtime.Frames) (shown []runtime.Frame) {
+	for more := true; more; {
+		var frame runtime.Frame
+		frame, more = frames.Next()
+		if frame.Function == "" || !this.Shows(frame) {
+			continue
+		}
+		shown = append(shown, frame)
+		if len(shown) == this.MaxFrames {
+			break
+		}
+	}
+	return shown
+}
+
+// Shows reports whether the frame is shown by the filter.
+func (this StackFilter) Shows(frame runtime.Frame) bool {
+	if isInternalFrame(frame.Function) || isHelper(frame.Function) {
+		return false
+	}
+	pkg := packageOf(frame.Function)
+	if matchesPackage(pkg, this.ExcludePackages) || matchesFile(frame.File, this.ExcludeFiles) {
+		return false
+	}
+	if len(this.IncludePackages) == 0 && len(this.IncludeFiles) == 0 {
+		return true
+	}
+	return matchesPackage(pkg, this.IncludePackages) || matchesFile(frame.File, this.IncludeFiles)
+}
+
+// matchesPackage reports whether the package (import path) is,
+// or is nested within, any of the packages named by the prefixes.
+func matchesPackage(pkg string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		prefix = strings.TrimSuffix(prefix, "/")
+		if pkg == prefix || strings.HasPrefix(pkg, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesFile(file string, patterns []string) bool {
+	for _, pattern := range patterns {
+		name := file
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(file)
+		}
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// packageOf derives the import path of the package from the
+// (fully-qualified) name of a function, as in:
+// "github.com/a/b.(*T).Method.func1" -> "github.com/a/b".
+// Type arguments (which may contain slashes and dots) are disregarded,
+// and dots within the last element of the import path (as in
+// "gopkg.in/yaml%2ev3.Marshal") are escaped in function names.
+func packageOf(function string) string {
+	name := function
+	if bracket := strings.Index(name, "["); bracket >= 0 {
+		name = name[:bracket]
+	}
+	slash := strings.LastIndex(name, "/")
+	if dot := strings.Index(name[slash+1:], "."); dot >= 0 {
+		name = name[:slash+1+dot]
+	}
+	if unescaped, err := url.PathUnescape(name); err == nil {
+		return unescaped
+	}
+	return name
+}
+
+// isInternalFrame reports whether the function belongs to this package
+// or to one of its sibling packages which invoke assertions (assert and
+// suite).
+func isInternalFrame(function string) bool {
+	pkg := packageOf(function)
+	for _, internal := range internalPackages {
+		if pkg == internal {
+			return true
+		}
+	}
+	return false
+}
+
+var internalPackages = []string{
+	packagePath,
+	strings.TrimSuffix(packagePath, "should") + "assert",
+	strings.TrimSuffix(packagePath, "should") + "suite",
+}
+
+// formatStack renders each frame on its own line, as in:
+// "> /home/me/project/service_test.go:42 (project.TestService)",
+// a form in which editors and IDEs recognize the file and line.
+func formatStack(frames []runtime.Frame) string {
+	lines := make([]string, 0, len(frames))
+	for _, frame := range frames {
+		qualifier := frame.Function // the import path, ignoring any type arguments
+		if bracket := strings.Index(qualifier, "["); bracket >= 0 {
+			qualifier = qualifier[:bracket]
+		}
+		function := frame.Function[strings.LastIndex(qualifier, "/")+1:]
+		lines = append(lines, fmt.Sprintf("> %s:%d (%s)", frame.File, frame.Line, function))
+	}
+	return strings.Join(lines, "\n")
+}
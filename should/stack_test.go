@@ -0,0 +1,176 @@
+package should_test
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestFailuresReportClickableStack(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		err  error
+		line int
+	}{
+		{"Equal", should.Equal(1, 2), currentLine()},
+		{"BeTrue", should.BeTrue(false), currentLine()},
+		{"NOT.BeNil", should.NOT.BeNil(nil), currentLine()},
+		{"All", should.All(should.Bind(should.Equal, 2))(1), currentLine()},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			failure := asAssertionError(t, test.err)
+			report := failure.Report()
+			want := fmt.Sprintf("stack_test.go:%d (should_test.TestFailuresReportClickableStack)", test.line)
+			if !strings.Contains(report, "Stack (filtered):\n> ") || !strings.Contains(report, want) {
+				t.Errorf("stack missing %q:\n%s", want, report)
+			}
+			if strings.Count(report, "Stack (filtered):") != 1 {
+				t.Errorf("expected a single stack:\n%s", report)
+			}
+			if strings.Contains(test.err.Error(), "Stack") {
+				t.Errorf("the error message should not include the stack:\n%s", test.err)
+			}
+			for _, frame := range failure.Stack {
+				if strings.Contains(frame.Function, "/should.") || strings.HasPrefix(frame.Function, "testing.") {
+					t.Errorf("unexpected frame: %s", frame.Function)
+				}
+			}
+		})
+	}
+}
+
+func TestHelperFramesAreOmitted(t *testing.T) {
+	err, line := failingHelper(), currentLine()
+
+	failure := asAssertionError(t, err)
+	if filepath.Base(failure.File) != "stack_test.go" || failure.Line != line {
+		t.Errorf("location: got %s:%d, want line %d", failure.File, failure.Line, line)
+	}
+	if strings.Contains(err.Error(), "failingHelper") {
+		t.Errorf("helper frame should be omitted:\n%s", err)
+	}
+}
+
+func failingHelper() error {
+	should.Helper()
+	return should.Equal(1, 2)
+}
+
+func TestTestingHelpersAreNotObserved(t *testing.T) {
+	line, err := testingHelper(t)
+
+	failure := asAssertionError(t, err)
+	if failure.Line != line {
+		t.Errorf("location: got line %d, want line %d (within the helper)", failure.Line, line)
+	}
+}
+
+func testingHelper(t *testing.T) (int, error) {
+	t.Helper() // (only affects the output of t.Log, t.Error, etc.)
+	return currentLine(), should.Equal(1, 2)
+}
+
+func TestStackFilterExclusions(t *testing.T) {
+	for _, filter := range []should.StackFilter{
+		{ExcludeFiles: []string{"stack_test.go"}},
+		{ExcludeFiles: []string{path.Join(testDirectory(), "*_test.go")}},
+		{ExcludePackages: []string{testPackage}},
+	} {
+		failure := withStackFilter(t, filter)
+
+		if len(failure.Stack) == 0 {
+			t.Errorf("%+v: other frames should remain", filter)
+		}
+		if failure.Location() == "stack_test.go:"+fmt.Sprint(failure.Line) {
+			t.Errorf("%+v: excluded frame reported as location", filter)
+		}
+		for _, frame := range failure.Stack {
+			if filepath.Base(frame.File) == "stack_test.go" {
+				t.Errorf("%+v: excluded frame shown: %s:%d", filter, frame.File, frame.Line)
+			}
+		}
+	}
+}
+
+func TestStackFilterInclusions(t *testing.T) {
+	for _, filter := range []should.StackFilter{
+		{IncludeFiles: []string{"*_test.go"}},
+		{IncludePackages: []string{testPackage}},
+	} {
+		failure := withStackFilter(t, filter)
+
+		if len(failure.Stack) == 0 || filepath.Base(failure.File) != "stack_test.go" {
+			t.Fatalf("%+v: expected (only) frames of this file, got %d", filter, len(failure.Stack))
+		}
+		for _, frame := range failure.Stack {
+			if filepath.Base(frame.File) != "stack_test.go" {
+				t.Errorf("%+v: unexpected frame: %s:%d", filter, frame.File, frame.Line)
+			}
+		}
+	}
+
+	failure := withStackFilter(t, should.StackFilter{IncludePackages: []string{"example.com/nothing"}})
+	if len(failure.Stack) != 0 || failure.Location() != "" || strings.Contains(failure.Report(), "Stack") {
+		t.Errorf("expected no stack, got: %s", failure.Report())
+	}
+}
+
+func TestStackFilterIdentifiesPackages(t *testing.T) {
+	for _, test := range []struct {
+		function string
+		pkg      string
+		other    string
+	}{
+		{"gopkg.in/yaml%2ev3.Marshal", "gopkg.in/yaml.v3", "gopkg.in/yaml"},
+		{"gopkg.in/yaml%2ev3.(*Decoder).Decode.func1", "gopkg.in/yaml.v3", "gopkg.in/yaml.v2"},
+		{"example.com/lib.Map[go.shape.string,example.com/other/types.T].func1", "example.com/lib", "example.com/other"},
+		{"example.com/lib.(*Set[example.com/other.T]).Add", "example.com/lib", "example.com/other"},
+		{"main.main", "main", "example.com/lib"},
+	} {
+		frame := runtime.Frame{Function: test.function, File: "/src/file.go"}
+
+		if (should.StackFilter{ExcludePackages: []string{test.pkg}}).Shows(frame) {
+			t.Errorf("%s: should be excluded as package %s", test.function, test.pkg)
+		}
+		if !(should.StackFilter{ExcludePackages: []string{test.other}}).Shows(frame) {
+			t.Errorf("%s: should not be excluded as package %s", test.function, test.other)
+		}
+		if !(should.StackFilter{IncludePackages: []string{test.pkg}}).Shows(frame) {
+			t.Errorf("%s: should be included as package %s", test.function, test.pkg)
+		}
+		if (should.StackFilter{IncludePackages: []string{test.other}}).Shows(frame) {
+			t.Errorf("%s: should not be included as package %s", test.function, test.other)
+		}
+	}
+}
+
+func TestStackFilterMaxFrames(t *testing.T) {
+	failure := withStackFilter(t, should.StackFilter{MaxFrames: 1})
+
+	if len(failure.Stack) != 1 {
+		t.Errorf("got %d frames, want 1", len(failure.Stack))
+	}
+}
+
+// withStackFilter reports the failure of an assertion
+// made while the provided filter is the default.
+func withStackFilter(t *testing.T, filter should.StackFilter) *should.AssertionError {
+	t.Helper()
+	original := should.DefaultStackFilter
+	defer func() { should.DefaultStackFilter = original }()
+	should.DefaultStackFilter = filter
+	return asAssertionError(t, should.Equal(1, 2))
+}
+
+var testPackage = reflect.TypeOf(named("")).PkgPath()
+
+func testDirectory() string {
+	_, file, _, _ := runtime.Caller(0)
+	return path.Dir(file)
+}
@@ -1,14 +1,19 @@
 package should
 
 import (
+	"errors"
 	"reflect"
 	"strings"
 )
 
-// StartWith verified that actual starts with expected[0].
-// The actual value may be an array, slice, or string.
-func StartWith(actual interface{}, expected ...interface{}) error {
-	err := validateExpected(1, expected)
+// StartWith verifies that actual starts with expected[0].
+// The actual value may be an array, slice, or string. When actual is an
+// array or slice, expected[0] may be a single element or (being an array
+// or slice itself) a sequence of elements with which actual starts, unless
+// the elements of actual are themselves arrays or slices.
+func StartWith(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
 	if err != nil {
 		return err
 	}
@@ -23,11 +28,17 @@ func StartWith(actual interface{}, expected ...interface{}) error {
 
 	switch reflect.TypeOf(actual).Kind() {
 	case reflect.Array, reflect.Slice:
+		if isSequenceOf(actualValue, EXPECTED) {
+			if sequenceAt(actualValue, reflect.ValueOf(EXPECTED), 0) {
+				return nil
+			}
+			break
+		}
 		if actualValue.Len() == 0 {
 			break
 		}
 		first := actualValue.Index(0).Interface()
-		if Equal(EXPECTED, first) == nil {
+		if equal(EXPECTED, first) {
 			return nil
 		}
 	case reflect.String:
@@ -49,10 +60,30 @@ func StartWith(actual interface{}, expected ...interface{}) error {
 	}
 
 	return failure("\n"+
-		"   proposed prefix: %#v\n"+
-		"   not a prefix of: %#v",
-		EXPECTED,
-		actual,
+		"   proposed prefix: %s\n"+
+		"   not a prefix of: %s",
+		Format(EXPECTED),
+		Format(actual),
+	)
+}
+
+// StartWith (negated!)
+func (negated) StartWith(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = StartWith(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("\n"+
+		"   prefix found: %s\n"+
+		"   within:       %s",
+		Format(expected[0]),
+		Format(actual),
 	)
 }
 
@@ -32,3 +32,30 @@ func TestShouldStartWith(t *testing.T) {
 	assert.Pass([3]byte{'a', 'b', 'c'}, should.StartWith, 'a')
 	assert.Pass([3]byte{'a', 'b', 'c'}, should.StartWith, 97)
 }
+
+func TestShouldStartWithSequence(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.Pass([]int{1, 2, 3}, should.StartWith, []int{1, 2})
+	assert.Pass([]int{1, 2, 3}, should.StartWith, [2]int64{1, 2})
+	assert.Pass([]int{1, 2, 3}, should.StartWith, []int{})
+	assert.Pass([3]int{1, 2, 3}, should.StartWith, []int{1, 2, 3})
+	assert.Fail([]int{1, 2, 3}, should.StartWith, []int{2, 3})
+	assert.Fail([]int{1, 2}, should.StartWith, []int{1, 2, 3})
+
+	// nested:
+	assert.Pass([][]int{{1, 2}, {3}}, should.StartWith, []int{1, 2})
+	assert.Fail([][]int{{1, 2}, {3}}, should.StartWith, []int{1})
+}
+
+func TestShouldNotStartWith(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid("actual", should.NOT.StartWith)
+	assert.KindMismatch(1, should.NOT.StartWith, "hi")
+
+	assert.Fail("integrate", should.NOT.StartWith, "in")
+	assert.Pass("integrate", should.NOT.StartWith, "ate")
+	assert.Fail([]int{1, 2, 3}, should.NOT.StartWith, []int{1, 2})
+	assert.Pass([]int{1, 2, 3}, should.NOT.StartWith, 2)
+}
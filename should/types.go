@@ -0,0 +1,313 @@
+package should
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// BeOfType verifies that the (dynamic) type of actual is identical to
+// the type provided as expected[0], which may be either a reflect.Type
+// or an example value of the type in question.
+func BeOfType(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	want := typeFrom(expected[0])
+	if reflect.TypeOf(actual) == want {
+		return nil
+	}
+
+	return failure("\n"+
+		"  got type:  %s\n"+
+		"  want type: %v",
+		typeChain(actual),
+		typeName(want),
+	)
+}
+
+// BeOfType (negated!)
+func (negated) BeOfType(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = BeOfType(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("got type %s, want any other type", typeChain(actual))
+}
+
+// BeKind verifies that the kind of actual is the reflect.Kind
+// provided as expected[0].
+func BeKind(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	err = validateType(expected[0], reflect.Invalid)
+	if err != nil {
+		return err
+	}
+
+	kind := reflect.ValueOf(actual).Kind()
+	if kind == expected[0] {
+		return nil
+	}
+
+	return failure("\n"+
+		"  got kind:  %s (type: %s)\n"+
+		"  want kind: %s",
+		kind,
+		typeChain(actual),
+		expected[0],
+	)
+}
+
+// BeKind (negated!)
+func (negated) BeKind(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = BeKind(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("got kind %s (type: %s), want any other kind", expected[0], typeChain(actual))
+}
+
+// Implement verifies that the (dynamic) type of actual implements the
+// interface type provided as expected[0], which may be either a pointer
+// to the interface type, as in (*io.Reader)(nil), or its reflect.Type.
+func Implement(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	iface, err := interfaceFrom(expected[0])
+	if err != nil {
+		return err
+	}
+
+	typ := reflect.TypeOf(actual)
+	if typ != nil && typ.Implements(iface) {
+		return nil
+	}
+
+	return failure("\n"+
+		"  type:               %s\n"+
+		"  does not implement: %v\n"+
+		"  missing methods:    %s",
+		typeChain(actual),
+		iface,
+		strings.Join(missingMethods(typ, iface), ", "),
+	)
+}
+
+// Implement (negated!)
+func (negated) Implement(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = Implement(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	iface, _ := interfaceFrom(expected[0])
+	return failure("type %s implements %v", typeChain(actual), iface)
+}
+
+// BeZeroValue verifies that actual is nil or the zero value of its type.
+func BeZeroValue(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(0, expected)
+	if err != nil {
+		return err
+	}
+
+	if actual == nil || reflect.ValueOf(actual).IsZero() {
+		return nil
+	}
+
+	return failure("\n"+
+		"  got:  %s\n"+
+		"  type: %s\n"+
+		"  want: the zero value",
+		Format(actual),
+		typeChain(actual),
+	)
+}
+
+// BeZeroValue (negated!)
+func (negated) BeZeroValue(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = BeZeroValue(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("got the zero value of %s, want any other value", typeChain(actual))
+}
+
+// BeOneOf verifies that actual is Equal to (at least) one of the
+// candidate values provided as expected.
+func BeOneOf(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpectedAtLeast(1, expected)
+	if err != nil {
+		return err
+	}
+
+	if oneOf(actual, expected) >= 0 {
+		return nil
+	}
+
+	return failure("\n"+
+		"  got:         %s\n"+
+		"  type:        %s\n"+
+		"  want one of: %s",
+		Format(actual),
+		typeChain(actual),
+		Format(expected),
+	)
+}
+
+// BeOneOf (negated!)
+func (negated) BeOneOf(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = BeOneOf(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failure("\n"+
+		"  got:          %s\n"+
+		"  equal to:     %s (candidate [%d])\n"+
+		"  want none of: %s",
+		Format(actual),
+		Format(expected[oneOf(actual, expected)]),
+		oneOf(actual, expected),
+		Format(expected),
+	)
+}
+
+// oneOf finds the index of the first candidate Equal to actual (or -1).
+func oneOf(actual interface{}, candidates []interface{}) int {
+	for x, candidate := range candidates {
+		if equal(actual, candidate) {
+			return x
+		}
+	}
+	return -1
+}
+
+// typeFrom interprets v as a reflect.Type (if it is one)
+// or as an example value of the type in question.
+func typeFrom(v interface{}) reflect.Type {
+	if typ, ok := v.(reflect.Type); ok {
+		return typ
+	}
+	return reflect.TypeOf(v)
+}
+
+// interfaceFrom interprets v as an interface type, provided
+// either as a pointer to the interface or as its reflect.Type.
+func interfaceFrom(v interface{}) (reflect.Type, error) {
+	iface := typeFrom(v)
+	if iface != nil && iface.Kind() == reflect.Ptr && iface.Elem().Kind() == reflect.Interface {
+		iface = iface.Elem()
+	}
+	if iface == nil || iface.Kind() != reflect.Interface {
+		return nil, wrap(ErrTypeMismatch, "got %v, want a pointer to an interface type, as in (*io.Reader)(nil)", typeName(iface))
+	}
+	return iface, nil
+}
+
+func typeName(typ reflect.Type) string {
+	if typ == nil {
+		return "<nil>"
+	}
+	return typ.String()
+}
+
+// typeChain renders the dynamic type of v, followed by the type of each
+// value reached by following (non-nil) pointers and interfaces, as in:
+// "*io.Reader -> io.Reader -> *bytes.Buffer -> bytes.Buffer".
+func typeChain(v interface{}) string {
+	value := reflect.ValueOf(v)
+	if !value.IsValid() {
+		return "<nil>"
+	}
+	chain := []string{value.Type().String()}
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			chain = append(chain, "<nil>")
+			break
+		}
+		value = value.Elem()
+		chain = append(chain, value.Type().String())
+	}
+	return strings.Join(chain, " -> ")
+}
+
+func missingMethods(typ, iface reflect.Type) (missing []string) {
+	for x := 0; x < iface.NumMethod(); x++ {
+		method := iface.Method(x)
+		if typ == nil {
+			missing = append(missing, method.Name)
+			continue
+		}
+		found, ok := typ.MethodByName(method.Name)
+		if !ok {
+			missing = append(missing, method.Name)
+		} else if found.Type.NumIn() > 0 && !methodMatches(found.Type, method.Type) {
+			missing = append(missing, fmt.Sprintf("%s (wrong signature: %v, want %v)", method.Name, found.Type, method.Type))
+		}
+	}
+	return missing
+}
+
+// methodMatches compares the signature of a method obtained from a
+// concrete type (whose first input is the receiver) to that of an
+// interface method (which has no receiver).
+func methodMatches(concrete, abstract reflect.Type) bool {
+	if concrete.NumIn()-1 != abstract.NumIn() || concrete.NumOut() != abstract.NumOut() || concrete.IsVariadic() != abstract.IsVariadic() {
+		return false
+	}
+	for x := 0; x < abstract.NumIn(); x++ {
+		if concrete.In(x+1) != abstract.In(x) {
+			return false
+		}
+	}
+	for x := 0; x < abstract.NumOut(); x++ {
+		if concrete.Out(x) != abstract.Out(x) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,145 @@
+package should_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestShouldBeOfType(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1, should.BeOfType)
+	assert.ExpectedCountInvalid(1, should.BeOfType, 1, 2)
+
+	assert.Pass(1, should.BeOfType, 0)
+	assert.Pass(1, should.BeOfType, reflect.TypeOf(0))
+	assert.Pass(new(bytes.Buffer), should.BeOfType, (*bytes.Buffer)(nil))
+	assert.Pass(nil, should.BeOfType, nil)
+	assert.Fail(1, should.BeOfType, int64(0))
+	assert.Fail(bytes.Buffer{}, should.BeOfType, new(bytes.Buffer))
+	assert.Fail(nil, should.BeOfType, 0)
+
+	assert.Fail(1, should.NOT.BeOfType, 0)
+	assert.Pass(1, should.NOT.BeOfType, "")
+}
+
+func TestShouldBeOfTypeReportsTypeChain(t *testing.T) {
+	var reader io.Reader = new(bytes.Buffer)
+
+	err := should.BeOfType(&reader, new(bytes.Buffer))
+
+	assertReportContains(t, err,
+		"got type:  *io.Reader -> io.Reader -> *bytes.Buffer -> bytes.Buffer",
+		"want type: *bytes.Buffer",
+	)
+}
+
+func TestShouldBeOfTypeReportsNilsWithinTypeChain(t *testing.T) {
+	var reader io.Reader
+	var buffer *bytes.Buffer
+
+	assertReportContains(t, should.BeOfType(&reader, 0), "*io.Reader -> io.Reader -> <nil>")
+	assertReportContains(t, should.BeOfType(buffer, 0), "*bytes.Buffer -> <nil>")
+}
+
+func TestShouldBeKind(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1, should.BeKind)
+	assert.TypeMismatch(1, should.BeKind, "int")
+
+	assert.Pass(1, should.BeKind, reflect.Int)
+	assert.Pass([]int{}, should.BeKind, reflect.Slice)
+	assert.Pass(nil, should.BeKind, reflect.Invalid)
+	assert.Fail(1, should.BeKind, reflect.Int64)
+
+	assert.Fail(1, should.NOT.BeKind, reflect.Int)
+	assert.Pass(1, should.NOT.BeKind, reflect.String)
+}
+
+type stringer struct{}
+
+func (*stringer) String() string { return "" }
+
+type mistyped struct{}
+
+func (mistyped) String(int) string { return "" }
+
+func TestShouldImplement(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1, should.Implement)
+	assert.TypeMismatch(1, should.Implement, 1)
+	assert.TypeMismatch(1, should.Implement, nil)
+	assert.TypeMismatch(1, should.Implement, new(bytes.Buffer))
+
+	assert.Pass(new(bytes.Buffer), should.Implement, (*io.Reader)(nil))
+	assert.Pass(new(bytes.Buffer), should.Implement, reflect.TypeOf((*io.ReadWriter)(nil)).Elem())
+	assert.Pass(new(stringer), should.Implement, (*fmt.Stringer)(nil))
+	assert.Fail(stringer{}, should.Implement, (*fmt.Stringer)(nil))
+	assert.Fail(bytes.Buffer{}, should.Implement, (*io.Reader)(nil))
+	assert.Fail(nil, should.Implement, (*io.Reader)(nil))
+	assert.Fail(1, should.Implement, (*io.Reader)(nil))
+
+	assert.Fail(new(bytes.Buffer), should.NOT.Implement, (*io.Reader)(nil))
+	assert.Pass(1, should.NOT.Implement, (*io.Reader)(nil))
+	assert.Fail(new(bytes.Buffer), should.NOT.Implement, reflect.TypeOf((*io.Reader)(nil)).Elem())
+	assert.TypeMismatch(1, should.NOT.Implement, 1)
+}
+
+func TestShouldImplementReportsMissingMethods(t *testing.T) {
+	assertReportContains(t, should.Implement(1, (*io.ReadCloser)(nil)),
+		"type:               int",
+		"does not implement: io.ReadCloser",
+		"missing methods:    Close, Read",
+	)
+	assertReportContains(t, should.Implement(mistyped{}, (*fmt.Stringer)(nil)),
+		"String (wrong signature: func(should_test.mistyped, int) string, want func() string)",
+	)
+}
+
+func TestShouldBeZeroValue(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(0, should.BeZeroValue, 0)
+
+	assert.Pass(nil, should.BeZeroValue)
+	assert.Pass(0, should.BeZeroValue)
+	assert.Pass("", should.BeZeroValue)
+	assert.Pass(bytes.Buffer{}, should.BeZeroValue)
+	assert.Pass((*bytes.Buffer)(nil), should.BeZeroValue)
+	assert.Pass([]int(nil), should.BeZeroValue)
+	assert.Fail([]int{}, should.BeZeroValue)
+	assert.Fail(1, should.BeZeroValue)
+	assert.Fail(Owner{Name: "Alice"}, should.BeZeroValue)
+
+	assert.Fail(0, should.NOT.BeZeroValue)
+	assert.Pass(1, should.NOT.BeZeroValue)
+}
+
+func TestShouldBeOneOf(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(1, should.BeOneOf)
+
+	assert.Pass(1, should.BeOneOf, 1)
+	assert.Pass(2, should.BeOneOf, 1, 2, 3)
+	assert.Pass(2, should.BeOneOf, 1.0, int64(2))
+	assert.Pass(Owner{Name: "Alice"}, should.BeOneOf, Owner{Name: "Bob"}, Owner{Name: "Alice"})
+	assert.Fail(4, should.BeOneOf, 1, 2, 3)
+	assert.Fail("1", should.BeOneOf, 1, 2, 3)
+
+	assert.Fail(2, should.NOT.BeOneOf, 1, 2, 3)
+	assert.Pass(4, should.NOT.BeOneOf, 1, 2, 3)
+}
+
+func TestShouldNotBeOneOfReportsMatchingCandidate(t *testing.T) {
+	err := should.NOT.BeOneOf(2, 1, 2, 3)
+
+	assertReportContains(t, err, "equal to:     2 (candidate [1])")
+}
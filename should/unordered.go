@@ -0,0 +1,274 @@
+package should
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ContainAll verifies that the collection provided as actual (a slice,
+// array, or map, whose keys are considered its elements) contains all of
+// the expected values, in any order. Values listed more than once must be
+// present (at least) as many times. Elements are compared using Equal.
+// See Values for considering the values of a map (rather than its keys).
+func ContainAll(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpectedAtLeast(1, expected)
+	if err != nil {
+		return err
+	}
+
+	elements, err := elementsOf(actual)
+	if err != nil {
+		return err
+	}
+
+	match := matchElements(elements, expected)
+	if len(match.missing) == 0 {
+		return nil
+	}
+
+	return failure("\n"+
+		"  missing: %s\n"+
+		"  within:  %s",
+		multiplicities(match.missing),
+		Format(actual),
+	)
+}
+
+// ContainAny verifies that the collection provided as actual (a slice,
+// array, or map, whose keys are considered its elements) contains at
+// least one of the expected values. Elements are compared using Equal.
+func ContainAny(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpectedAtLeast(1, expected)
+	if err != nil {
+		return err
+	}
+
+	elements, err := elementsOf(actual)
+	if err != nil {
+		return err
+	}
+
+	match := matchElements(elements, expected)
+	if len(match.found) > 0 {
+		return nil
+	}
+
+	return failure("\n"+
+		"  none of: %s\n"+
+		"  within:  %s",
+		multiplicities(expected),
+		Format(actual),
+	)
+}
+
+// ContainNone verifies that the collection provided as actual (a slice,
+// array, or map, whose keys are considered its elements) contains none
+// of the expected values. Elements are compared using Equal.
+func ContainNone(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpectedAtLeast(1, expected)
+	if err != nil {
+		return err
+	}
+
+	elements, err := elementsOf(actual)
+	if err != nil {
+		return err
+	}
+
+	var found []interface{}
+	for _, element := range elements {
+		for _, item := range expected {
+			if equal(element, item) {
+				found = append(found, element)
+				break
+			}
+		}
+	}
+	if len(found) == 0 {
+		return nil
+	}
+
+	return failure("\n"+
+		"  found:  %s\n"+
+		"  within: %s",
+		multiplicities(found),
+		Format(actual),
+	)
+}
+
+// ContainExactly verifies that the collection provided as actual (a slice,
+// array, or map, whose keys are considered its elements) contains exactly
+// the expected values (each as many times as listed), in any order.
+// Elements are compared using Equal.
+func ContainExactly(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	elements, err := elementsOf(actual)
+	if err != nil {
+		return err
+	}
+
+	return sameElements(actual, elements, expected)
+}
+
+// HaveSameElements verifies that the collection provided as actual and
+// the collection provided as expected[0] (each a slice, array, or map,
+// whose keys are considered its elements) contain the same elements (each
+// the same number of times), in any order. Elements are compared using Equal.
+func HaveSameElements(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	elements, err := elementsOf(actual)
+	if err != nil {
+		return err
+	}
+
+	others, err := elementsOf(expected[0])
+	if err != nil {
+		return err
+	}
+
+	return sameElements(actual, elements, others)
+}
+
+func sameElements(actual interface{}, elements, expected []interface{}) error {
+	match := matchElements(elements, expected)
+	if len(match.missing) == 0 && len(match.unexpected) == 0 {
+		return nil
+	}
+
+	return failure("\n"+
+		"  missing:    %s\n"+
+		"  unexpected: %s\n"+
+		"  within:     %s",
+		multiplicities(match.missing),
+		multiplicities(match.unexpected),
+		Format(actual),
+	)
+}
+
+// MapValues selects the values of a map (see Values).
+type MapValues struct{ Map interface{} }
+
+// Values selects the values (rather than the keys) of the map provided
+// as the elements to be considered by ContainAll, ContainAny, ContainNone,
+// ContainExactly and HaveSameElements (as either actual or expected[0]),
+// as in: should.ContainExactly(should.Values(ages), 42, 42, 7).
+func Values(m interface{}) MapValues {
+	return MapValues{Map: m}
+}
+
+// elementsOf lists the elements of a slice or array, the keys
+// of a map, or the values of a map selected via Values.
+func elementsOf(collection interface{}) ([]interface{}, error) {
+	if values, ok := collection.(MapValues); ok {
+		err := validateKind(values.Map, reflect.Map)
+		if err != nil {
+			return nil, err
+		}
+		value := reflect.ValueOf(values.Map)
+		elements := make([]interface{}, 0, value.Len())
+		for _, key := range value.MapKeys() {
+			elements = append(elements, value.MapIndex(key).Interface())
+		}
+		return elements, nil
+	}
+
+	err := validateKind(collection, collectionKinds...)
+	if err != nil {
+		return nil, err
+	}
+
+	value := reflect.ValueOf(collection)
+	if value.Kind() == reflect.Map {
+		return interfaces(value.MapKeys()), nil
+	}
+
+	elements := make([]interface{}, value.Len())
+	for x := range elements {
+		elements[x] = value.Index(x).Interface()
+	}
+	return elements, nil
+}
+
+func interfaces(values []reflect.Value) []interface{} {
+	result := make([]interface{}, len(values))
+	for x, value := range values {
+		result[x] = value.Interface()
+	}
+	return result
+}
+
+// elementMatch is the outcome of pairing off the elements of
+// a collection with the expected values (see matchElements).
+type elementMatch struct {
+	found      []interface{} // expected values paired with an element
+	missing    []interface{} // expected values without a corresponding element
+	unexpected []interface{} // elements without a corresponding expected value
+}
+
+// matchElements pairs each expected value with a distinct
+// (and as yet unpaired) element to which it is Equal.
+func matchElements(elements, expected []interface{}) (match elementMatch) {
+	paired := make([]bool, len(elements))
+	for _, item := range expected {
+		found := false
+		for x, element := range elements {
+			if !paired[x] && equal(element, item) {
+				paired[x], found = true, true
+				break
+			}
+		}
+		if found {
+			match.found = append(match.found, item)
+		} else {
+			match.missing = append(match.missing, item)
+		}
+	}
+	for x, element := range elements {
+		if !paired[x] {
+			match.unexpected = append(match.unexpected, element)
+		}
+	}
+	return match
+}
+
+// multiplicities renders the values, grouping those that
+// are Equal and noting the number of times each occurs.
+func multiplicities(values []interface{}) string {
+	var distinct []interface{}
+	var counts []int
+	for _, value := range values {
+		found := false
+		for x, d := range distinct {
+			if equal(value, d) {
+				counts[x]++
+				found = true
+				break
+			}
+		}
+		if !found {
+			distinct = append(distinct, value)
+			counts = append(counts, 1)
+		}
+	}
+
+	rendered := make([]string, len(distinct))
+	for x, value := range distinct {
+		rendered[x] = fmt.Sprintf("%dx %s", counts[x], Format(value))
+	}
+	return "[" + strings.Join(rendered, ", ") + "]"
+}
+
+var collectionKinds = []reflect.Kind{
+	reflect.Map,
+	reflect.Array,
+	reflect.Slice,
+}
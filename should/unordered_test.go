@@ -0,0 +1,112 @@
+package should_test
+
+import (
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestShouldContainAll(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid([]int{1}, should.ContainAll)
+	assert.KindMismatch("abc", should.ContainAll, "a")
+
+	assert.Pass([]int{3, 2, 1}, should.ContainAll, 1, 2)
+	assert.Pass([2]int{1, 1}, should.ContainAll, 1, uint(1))
+	assert.Pass(map[string]int{"a": 1, "b": 2}, should.ContainAll, "b", "a")
+	assert.Fail([]int{1, 2}, should.ContainAll, 1, 1)
+	assert.Fail([]int{1, 2}, should.ContainAll, 3)
+	assert.Fail([]int(nil), should.ContainAll, 1)
+}
+
+func TestShouldContainAny(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid([]int{1}, should.ContainAny)
+	assert.KindMismatch(1, should.ContainAny, 1)
+
+	assert.Pass([]int{1, 2}, should.ContainAny, 3, 2)
+	assert.Pass(map[int]bool{1: true}, should.ContainAny, 1.0)
+	assert.Fail([]int{1, 2}, should.ContainAny, 3, 4)
+}
+
+func TestShouldContainNone(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid([]int{1}, should.ContainNone)
+	assert.KindMismatch(1, should.ContainNone, 1)
+
+	assert.Pass([]int{1, 2}, should.ContainNone, 3, 4)
+	assert.Fail([]int{1, 2, 2}, should.ContainNone, 2, 4)
+	assert.Fail(map[string]int{"a": 1}, should.ContainNone, "a")
+}
+
+func TestShouldContainExactly(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.KindMismatch("abc", should.ContainExactly, "a", "b", "c")
+
+	assert.Pass([]int(nil), should.ContainExactly)
+	assert.Pass([]int{3, 1, 2, 1}, should.ContainExactly, 1, 1, 2, 3)
+	assert.Pass(map[string]int{"a": 1, "b": 2}, should.ContainExactly, "b", "a")
+	assert.Fail([]int{1, 2, 2}, should.ContainExactly, 1, 1, 2)
+	assert.Fail([]int{1, 2}, should.ContainExactly, 1)
+	assert.Fail([]int{1}, should.ContainExactly, 1, 2)
+}
+
+func TestShouldHaveSameElements(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid([]int{1}, should.HaveSameElements)
+	assert.ExpectedCountInvalid([]int{1}, should.HaveSameElements, []int{1}, "EXTRA")
+	assert.KindMismatch("abc", should.HaveSameElements, []string{"a"})
+	assert.KindMismatch([]string{"a"}, should.HaveSameElements, "abc")
+
+	assert.Pass([]int{2, 1, 1}, should.HaveSameElements, [3]int64{1, 1, 2})
+	assert.Pass([]string{"b", "a"}, should.HaveSameElements, map[string]bool{"a": true, "b": false})
+	assert.Pass([]int{}, should.HaveSameElements, []int(nil))
+	assert.Fail([]int{2, 1}, should.HaveSameElements, []int{1, 1, 2})
+	assert.Fail([]int{2, 1, 3}, should.HaveSameElements, []int{1, 2, 4})
+}
+
+func TestShouldHaveSameElementsScalesToLargeCollections(t *testing.T) {
+	assert := NewAssertion(t)
+
+	forward := make([]int, 500)
+	backward := make([]int, len(forward))
+	for x := range forward {
+		forward[x] = x
+		backward[len(backward)-1-x] = x
+	}
+
+	assert.Pass(forward, should.HaveSameElements, backward)
+	assert.Pass(forward, should.ContainNone, []int{-1, -2, -3})
+	assert.Fail(forward, should.HaveSameElements, append(backward[1:], -1))
+}
+
+func TestUnorderedAssertionsConsiderMapValues(t *testing.T) {
+	assert := NewAssertion(t)
+	ages := map[string]int{"alice": 42, "bob": 42, "carol": 7}
+
+	assert.KindMismatch(should.Values([]int{1}), should.ContainAll, 1)
+	assert.KindMismatch([]int{1}, should.HaveSameElements, should.Values("abc"))
+
+	assert.Pass(should.Values(ages), should.ContainAll, 42, 42)
+	assert.Pass(should.Values(ages), should.ContainAny, 7)
+	assert.Pass(should.Values(ages), should.ContainNone, "alice")
+	assert.Pass(should.Values(ages), should.ContainExactly, 7, 42, 42)
+	assert.Pass([]int{42, 7, 42}, should.HaveSameElements, should.Values(ages))
+	assert.Fail(should.Values(ages), should.ContainAll, 7, 7)
+	assert.Fail(should.Values(ages), should.ContainExactly, 7, 42)
+	assert.Fail(ages, should.ContainAny, 7)
+}
+
+func TestShouldHaveSameElementsReportsMultiplicities(t *testing.T) {
+	err := should.HaveSameElements([]string{"a", "b", "b", "b"}, []string{"a", "a", "a", "c"})
+
+	assertReportContains(t, err,
+		`missing:    [2x "a", 1x "c"]`,
+		`unexpected: [3x "b"]`,
+	)
+}
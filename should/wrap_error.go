@@ -8,8 +8,9 @@ import (
 
 // WrapError uses errors.Is to verify that actual is an error value
 // that wraps expected[0] (also an error value).
-func WrapError(actual interface{}, expected ...interface{}) error {
-	err := validateExpected(1, expected)
+func WrapError(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
 	if err != nil {
 		return err
 	}
@@ -28,12 +29,13 @@ func WrapError(actual interface{}, expected ...interface{}) error {
 		return nil
 	}
 
-	return fmt.Errorf("%w:\n"+
+	return failure("\n"+
 		"\t            outer err: (%s)\n"+
-		"\tshould wrap inner err: (%s)",
-		ErrAssertionFailure,
+		"\tshould wrap inner err: (%s)\n"+
+		"\terror chain:\n%s",
 		outer,
 		inner,
+		errorChain(outer),
 	)
 }
 
@@ -0,0 +1,96 @@
+package should
+
+import (
+	"errors"
+	"reflect"
+)
+
+// WrapErrorAs uses errors.As to verify that actual is an error value whose
+// chain contains an error assignable to the type indicated by expected[0].
+// The expected value may be either:
+//   - a non-nil pointer to a variable of an error type (or of an interface
+//     type), exactly as errors.As expects, in which case the matching error
+//     is captured into that variable, or
+//   - an example value of the desired error type, such as (*MyError)(nil).
+func WrapErrorAs(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = validateExpected(1, expected)
+	if err != nil {
+		return err
+	}
+
+	outer, ok := actual.(error)
+	if !ok {
+		return errTypeMismatch(actual)
+	}
+
+	target, err := errorsAsTarget(expected[0])
+	if err != nil {
+		return err
+	}
+
+	if errors.As(outer, target.Interface()) {
+		return nil
+	}
+
+	return failure("\n"+
+		"\t         outer err: (%s)\n"+
+		"\tshould wrap a type: %s\n"+
+		"\terror chain:\n%s",
+		outer,
+		target.Type().Elem(),
+		errorChain(outer),
+	)
+}
+
+// WrapErrorAs (negated!)
+func (negated) WrapErrorAs(actual interface{}, expected ...interface{}) (err error) {
+	defer annotate(&err, actual, expected)
+	err = WrapErrorAs(actual, expected...)
+	if errors.Is(err, ErrAssertionFailure) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	target, _ := errorsAsTarget(expected[0])
+	return failure("\n"+
+		"\t             outer err: (%s)\n"+
+		"\tshould not wrap a type: %s\n"+
+		"\terror chain:\n%s",
+		actual,
+		target.Type().Elem(),
+		errorChain(actual.(error)),
+	)
+}
+
+// errorsAsTarget resolves the expected value of WrapErrorAs
+// into a (non-nil) pointer suitable for use with errors.As.
+func errorsAsTarget(expected interface{}) (reflect.Value, error) {
+	if expected == nil {
+		return reflect.Value{}, errTypeMismatch(expected)
+	}
+
+	// A target is recognized first, as a pointer to an error type with a
+	// value receiver (ie. &MyError{}) also implements error itself.
+	value := reflect.ValueOf(expected)
+	if value.Kind() == reflect.Ptr && !value.IsNil() {
+		elem := value.Type().Elem()
+		if elem.Kind() == reflect.Interface || elem.Implements(errorType) {
+			return value, nil // errors.As target
+		}
+	}
+
+	if value.Type().Implements(errorType) {
+		return reflect.New(value.Type()), nil // example value
+	}
+
+	return reflect.Value{}, wrap(ErrTypeMismatch,
+		"got %s, want a pointer to an error (or interface) type, or an example error value",
+		value.Type(),
+	)
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
@@ -0,0 +1,105 @@
+package should_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+)
+
+func TestShouldWrapErrorAs(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(pathErr, should.WrapErrorAs)
+	assert.ExpectedCountInvalid(pathErr, should.WrapErrorAs, &target, "EXTRA")
+
+	assert.TypeMismatch(42, should.WrapErrorAs, &target)
+	assert.TypeMismatch(pathErr, should.WrapErrorAs, nil)
+	assert.TypeMismatch(pathErr, should.WrapErrorAs, 42)
+	assert.TypeMismatch(pathErr, should.WrapErrorAs, new(int))
+
+	assert.Pass(wrappedPathErr, should.WrapErrorAs, (*os.PathError)(nil))
+	assert.Pass(wrappedPathErr, should.WrapErrorAs, &target)
+	assert.Pass(joined{inner, wrappedPathErr}, should.WrapErrorAs, &target)
+	assert.Fail(outer, should.WrapErrorAs, (*os.PathError)(nil))
+	assert.Fail(outer, should.WrapErrorAs, &target)
+
+	var timeout interface{ Timeout() bool }
+	assert.Pass(wrappedPathErr, should.WrapErrorAs, &timeout)
+}
+
+func TestShouldWrapErrorAsCapturesTarget(t *testing.T) {
+	var captured *os.PathError
+
+	err := should.WrapErrorAs(wrappedPathErr, &captured)
+
+	if err != nil {
+		t.Fatal("unexpected err:", err)
+	}
+	if captured != pathErr {
+		t.Errorf("got %v, want %v", captured, pathErr)
+	}
+}
+
+func TestShouldWrapErrorAsCapturesValueReceiverTarget(t *testing.T) {
+	var captured valueError
+
+	err := should.WrapErrorAs(fmt.Errorf("outer: %w", valueError{code: 42}), &captured)
+
+	if err != nil {
+		t.Fatal("unexpected err:", err)
+	}
+	if captured.code != 42 {
+		t.Errorf("got %v, want code 42", captured)
+	}
+	NewAssertion(t).Pass(valueError{}, should.WrapErrorAs, valueError{})
+}
+
+func TestShouldNotWrapErrorAs(t *testing.T) {
+	assert := NewAssertion(t)
+
+	assert.ExpectedCountInvalid(pathErr, should.NOT.WrapErrorAs)
+	assert.TypeMismatch(42, should.NOT.WrapErrorAs, &target)
+
+	assert.Fail(wrappedPathErr, should.NOT.WrapErrorAs, &target)
+	assert.Pass(outer, should.NOT.WrapErrorAs, (*os.PathError)(nil))
+}
+
+func TestErrorChainRendersMultiErrorTrees(t *testing.T) {
+	err := should.WrapError(fmt.Errorf("top: %w", joined{inner, wrappedPathErr}), notNil)
+
+	assertReportContains(t, err,
+		"error chain:\n"+
+			"\t(*fmt.wrapError) top: inner; wrapped: open file: not found\n"+
+			"\t  (should_test.joined) inner; wrapped: open file: not found\n"+
+			"\t    (*errors.errorString) inner\n"+
+			"\t    (*fmt.wrapError) wrapped: open file: not found\n"+
+			"\t      (*fs.PathError) open file: not found\n"+
+			"\t        (*errors.errorString) not found",
+	)
+}
+
+var (
+	target         *os.PathError
+	pathErr        = &os.PathError{Op: "open", Path: "file", Err: errors.New("not found")}
+	wrappedPathErr = fmt.Errorf("wrapped: %w", pathErr)
+)
+
+// joined mimics the multi-error produced by errors.Join (Go 1.20+).
+type joined []error
+
+func (this joined) Error() string {
+	var messages []string
+	for _, err := range this {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+func (this joined) Unwrap() []error { return this }
+
+type valueError struct{ code int }
+
+func (this valueError) Error() string { return fmt.Sprint("code ", this.code) }
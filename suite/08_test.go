@@ -0,0 +1,29 @@
+package suite_test
+
+import (
+	"testing"
+
+	"github.com/mdwhatcott/testing/should"
+	"github.com/mdwhatcott/testing/suite"
+)
+
+// The snapshots are found under testdata/snapshots.
+func TestSuiteWithSnapshots(t *testing.T) {
+	fixture := &Suite08{T: suite.New(t)}
+
+	suite.Run(fixture, suite.Options.SharedFixture())
+
+	fixture.So(fixture.matched, should.Equal, []bool{true, true})
+}
+
+type Suite08 struct {
+	*suite.T
+	matched []bool
+}
+
+func (this *Suite08) TestSnapshot() {
+	this.matched = append(this.matched,
+		this.MatchSnapshot("unlabeled"),
+		this.MatchSnapshot("labeled", "label"),
+	)
+}
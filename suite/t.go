@@ -1,6 +1,10 @@
 package suite
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/danyloB/Testing/should"
+)
 
 // T embeds *testing.T and provides convenient
 // hooks for making assertions and other operations.
@@ -17,7 +21,7 @@ func (this *T) So(actual interface{}, assertion assertion, expected ...interface
 	err := assertion(actual, expected...)
 	if err != nil {
 		this.Helper()
-		this.Error(err)
+		this.Error(should.Describe(err))
 	}
 	return err == nil
 }
@@ -27,11 +31,23 @@ func (this *T) FatalSo(actual interface{}, assertion assertion, expected ...inte
 	err := assertion(actual, expected...)
 	if err != nil {
 		this.Helper()
-		this.Fatal(err)
+		this.Fatal(should.Describe(err))
 	}
 	return true
 }
 
+// MatchSnapshot is like So(actual, should.MatchSnapshot, this, label...),
+// keying the snapshot by the name of the current test (and the optional
+// label, to distinguish several snapshots made by a single test).
+func (this *T) MatchSnapshot(actual interface{}, label ...string) bool {
+	this.Helper()
+	expected := []interface{}{this}
+	for _, l := range label {
+		expected = append(expected, l)
+	}
+	return this.So(actual, should.MatchSnapshot, expected...)
+}
+
 // Write implements io.Writer allowing for the
 // suite to serve as a convenient log target,
 // among other use cases.